@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type Array interface {
@@ -18,7 +19,8 @@ type Array interface {
 }
 
 type arrayType struct {
-	elem Type
+	elemOnce sync.Once
+	elem     Type
 
 	parent       Type
 	reflectType  reflect.Type
@@ -30,7 +32,7 @@ func (a *arrayType) Name() string {
 	builder.WriteString("[")
 	builder.WriteString(fmt.Sprintf("%d", a.Len()))
 	builder.WriteString("]")
-	builder.WriteString(a.elem.Name())
+	builder.WriteString(a.elemType().Name())
 	return builder.String()
 }
 
@@ -83,6 +85,14 @@ func (a *arrayType) ReflectValue() *reflect.Value {
 	return a.reflectValue
 }
 
+func (a *arrayType) Kind() Kind {
+	return KindArray
+}
+
+func (a *arrayType) UnderlyingKind() Kind {
+	return KindArray
+}
+
 func (a *arrayType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -134,7 +144,7 @@ func (a *arrayType) Convert(typ Type) (Value, error) {
 }
 
 func (a *arrayType) Elem() Type {
-	return a.elem
+	return a.elemType()
 }
 
 func (a *arrayType) Len() int {
@@ -162,7 +172,7 @@ func (a *arrayType) Set(index int, val any) error {
 		return errors.New("array index out of range")
 	}
 
-	a.reflectValue.Index(index).Set(reflect.ValueOf(val))
+	a.reflectValue.Index(index).Set(valueOrZero(val, a.reflectType.Elem()))
 	return nil
 }
 
@@ -189,3 +199,11 @@ func (a *arrayType) Copy(dst any) (int, error) {
 
 	return reflect.Copy(reflect.ValueOf(dst), *a.reflectValue), nil
 }
+
+func (a *arrayType) elemType() Type {
+	a.elemOnce.Do(func() {
+		a.elem = typeOf(nil, a.reflectType.Elem(), a.reflectValue, a)
+	})
+
+	return a.elem
+}
@@ -74,6 +74,14 @@ func (b *booleanType) ReflectValue() *reflect.Value {
 	return b.reflectValue
 }
 
+func (b *booleanType) Kind() Kind {
+	return KindBoolean
+}
+
+func (b *booleanType) UnderlyingKind() Kind {
+	return KindBoolean
+}
+
 func (b *booleanType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type ChanDirection int
@@ -27,6 +28,7 @@ type Chan interface {
 
 type chanType struct {
 	parent       Type
+	elemOnce     sync.Once
 	elem         Type
 	reflectType  reflect.Type
 	reflectValue *reflect.Value
@@ -46,7 +48,7 @@ func (c *chanType) Name() string {
 	}
 
 	builder.WriteString(" ")
-	builder.WriteString(c.elem.Name())
+	builder.WriteString(c.elemType().Name())
 
 	return builder.String()
 }
@@ -100,6 +102,14 @@ func (c *chanType) ReflectValue() *reflect.Value {
 	return c.reflectValue
 }
 
+func (c *chanType) Kind() Kind {
+	return KindChan
+}
+
+func (c *chanType) UnderlyingKind() Kind {
+	return KindChan
+}
+
 func (c *chanType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -211,7 +221,7 @@ func (c *chanType) Direction() ChanDirection {
 }
 
 func (c *chanType) Elem() Type {
-	return c.elem
+	return c.elemType()
 }
 
 func (c *chanType) Cap() (int, error) {
@@ -221,3 +231,11 @@ func (c *chanType) Cap() (int, error) {
 
 	return c.reflectValue.Cap(), nil
 }
+
+func (c *chanType) elemType() Type {
+	c.elemOnce.Do(func() {
+		c.elem = typeOf(nil, c.reflectType.Elem(), c.reflectValue, c)
+	})
+
+	return c.elem
+}
@@ -0,0 +1,256 @@
+package reflector
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+func convertValue(val any, typ reflect.Type) (reflect.Value, error) {
+	if val == nil {
+		return reflect.Zero(typ), nil
+	}
+
+	return convertReflectValue(reflect.ValueOf(val), typ)
+}
+
+func convertReflectValue(val reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	for val.Kind() == reflect.Interface && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if !val.IsValid() || (val.Kind() == reflect.Interface && val.IsNil()) {
+		return reflect.Zero(typ), nil
+	}
+
+	if val.Type().AssignableTo(typ) {
+		return val, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		if val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return reflect.Zero(typ), nil
+			}
+
+			val = val.Elem()
+		}
+
+		elem, err := convertReflectValue(val, typ.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		ptr := reflect.New(typ.Elem())
+		ptr.Elem().Set(elem)
+		return ptr, nil
+	case reflect.Interface:
+		if val.Type().Implements(typ) {
+			converted := reflect.New(typ).Elem()
+			converted.Set(val)
+			return converted, nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if isNumberKind(val.Kind()) {
+			number, ok := integerOf(val)
+			if !ok || reflect.Zero(typ).OverflowInt(number) {
+				return reflect.Value{}, fmt.Errorf("%v overflows %s", val, typ.String())
+			}
+
+			return reflect.ValueOf(number).Convert(typ), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if isNumberKind(val.Kind()) {
+			number, ok := integerOf(val)
+			if !ok || number < 0 || reflect.Zero(typ).OverflowUint(uint64(number)) {
+				if val.CanUint() {
+					if !reflect.Zero(typ).OverflowUint(val.Uint()) {
+						return reflect.ValueOf(val.Uint()).Convert(typ), nil
+					}
+				}
+
+				return reflect.Value{}, fmt.Errorf("%v overflows %s", val, typ.String())
+			}
+
+			return reflect.ValueOf(uint64(number)).Convert(typ), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if isNumberKind(val.Kind()) {
+			number := val.Convert(reflect.TypeOf(float64(0))).Float()
+			if reflect.Zero(typ).OverflowFloat(number) {
+				return reflect.Value{}, fmt.Errorf("%v overflows %s", val, typ.String())
+			}
+
+			return reflect.ValueOf(number).Convert(typ), nil
+		}
+	case reflect.String, reflect.Bool, reflect.Complex64, reflect.Complex128:
+		if val.Kind() == typ.Kind() {
+			return val.Convert(typ), nil
+		}
+	case reflect.Slice:
+		if val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+			if val.Kind() == reflect.Slice && val.IsNil() {
+				return reflect.Zero(typ), nil
+			}
+
+			converted := reflect.MakeSlice(typ, val.Len(), val.Len())
+			if err := convertElements(val, converted); err != nil {
+				return reflect.Value{}, err
+			}
+
+			return converted, nil
+		}
+	case reflect.Array:
+		if val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+			if val.Len() > typ.Len() {
+				return reflect.Value{}, fmt.Errorf("%d elements exceed %s", val.Len(), typ.String())
+			}
+
+			converted := reflect.New(typ).Elem()
+			if err := convertElements(val, converted); err != nil {
+				return reflect.Value{}, err
+			}
+
+			return converted, nil
+		}
+	case reflect.Map:
+		if val.Kind() == reflect.Map {
+			if val.IsNil() {
+				return reflect.Zero(typ), nil
+			}
+
+			converted := reflect.MakeMapWithSize(typ, val.Len())
+			iterator := val.MapRange()
+
+			for iterator.Next() {
+				key, err := convertMapKey(iterator.Key(), typ.Key())
+				if err != nil {
+					return reflect.Value{}, err
+				}
+
+				elem, err := convertReflectValue(iterator.Value(), typ.Elem())
+				if err != nil {
+					return reflect.Value{}, fmt.Errorf("%s: %w", formatKey(key), err)
+				}
+
+				converted.SetMapIndex(key, elem)
+			}
+
+			return converted, nil
+		}
+	case reflect.Struct:
+		if val.Kind() == reflect.Map && val.Type().Key().Kind() == reflect.String {
+			converted := reflect.New(typ).Elem()
+			structType := ToStruct(typeOf(reflect.PtrTo(typ), typ, &converted, nil))
+			iterator := val.MapRange()
+
+			for iterator.Next() {
+				name := iterator.Key().String()
+				structField, exists := fieldByVisibleName(structType, name, "json")
+
+				if !exists {
+					continue
+				}
+
+				elem, err := convertReflectValue(iterator.Value(), structField.ReflectStructField().Type)
+				if err != nil {
+					return reflect.Value{}, fmt.Errorf("%s: %w", name, err)
+				}
+
+				if err = structField.SetValueAllocating(elem.Interface()); err != nil {
+					return reflect.Value{}, fmt.Errorf("%s: %w", name, err)
+				}
+			}
+
+			return converted, nil
+		}
+	}
+
+	if val.Kind() == typ.Kind() {
+		source := typeOf(reflect.PtrTo(val.Type()), val.Type(), &val, nil)
+		target := typeOf(reflect.PtrTo(typ), typ, nil, nil)
+
+		if source != nil && target != nil && source.CanConvert(target) {
+			if converted, err := source.Convert(target); err == nil {
+				return converted.(*value).reflectValue, nil
+			}
+		}
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot convert %s to %s", val.Type().String(), typ.String())
+}
+
+func convertElements(src reflect.Value, dst reflect.Value) error {
+	length := src.Len()
+
+	for i := 0; i < length; i++ {
+		elem, err := convertReflectValue(src.Index(i), dst.Type().Elem())
+		if err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		dst.Index(i).Set(elem)
+	}
+
+	return nil
+}
+
+func convertMapKey(key reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	for key.Kind() == reflect.Interface && !key.IsNil() {
+		key = key.Elem()
+	}
+
+	if key.Kind() == reflect.String && typ.Kind() != reflect.String {
+		return segmentKey(typ, pathSegment{key: key.String()})
+	}
+
+	return convertReflectValue(key, typ)
+}
+
+func integerOf(val reflect.Value) (int64, bool) {
+	switch {
+	case val.CanInt():
+		return val.Int(), true
+	case val.CanUint():
+		if val.Uint() > math.MaxInt64 {
+			return 0, false
+		}
+
+		return int64(val.Uint()), true
+	default:
+		number := val.Float()
+		if number != math.Trunc(number) || number < math.MinInt64 || number >= math.MaxInt64 {
+			return 0, false
+		}
+
+		return int64(number), true
+	}
+}
+
+func fieldByVisibleName(structType Struct, name string, key string) (Field, bool) {
+	fields := structType.VisibleFieldsByTag(key)
+
+	for _, field := range fields {
+		if field.VisibleName() == name {
+			return field, true
+		}
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.VisibleName(), name) {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+func valueOrZero(val any, typ reflect.Type) reflect.Value {
+	if val == nil {
+		return reflect.Zero(typ)
+	}
+
+	return reflect.ValueOf(val)
+}
@@ -0,0 +1,331 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+type CopyOption func(options *copyOptions)
+
+type copyOptions struct {
+	skipUnexported bool
+	freshChannels  bool
+}
+
+func SkipUnexported() CopyOption {
+	return func(options *copyOptions) {
+		options.skipUnexported = true
+	}
+}
+
+func FreshChannels() CopyOption {
+	return func(options *copyOptions) {
+		options.freshChannels = true
+	}
+}
+
+type copyMode int
+
+const (
+	deepCopyMode copyMode = iota
+	shallowCopyMode
+	ignoreCopyMode
+)
+
+type copyField struct {
+	index    int
+	exported bool
+	mode     copyMode
+}
+
+type sliceVisit struct {
+	ptr uintptr
+	len int
+	typ reflect.Type
+}
+
+type copier struct {
+	options     copyOptions
+	packagePath string
+	visited     map[visit]reflect.Value
+	slices      map[sliceVisit]reflect.Value
+	fields      map[reflect.Type][]copyField
+	opaque      map[reflect.Type]bool
+}
+
+func newCopier(opts []CopyOption) *copier {
+	c := &copier{
+		visited: make(map[visit]reflect.Value),
+		slices:  make(map[sliceVisit]reflect.Value),
+		fields:  make(map[reflect.Type][]copyField),
+		opaque:  make(map[reflect.Type]bool),
+	}
+
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+
+	return c
+}
+
+func Clone[T any](v T, opts ...CopyOption) T {
+	src := reflect.ValueOf(&v).Elem()
+	result := reflect.New(src.Type())
+	result.Elem().Set(newCopier(opts).copy(src))
+	return *result.Interface().(*T)
+}
+
+func DeepCopy(dst, src any, opts ...CopyOption) error {
+	dstVal := reflect.ValueOf(dst)
+
+	if dstVal.Kind() != reflect.Ptr || dstVal.IsNil() {
+		return errors.New("dst should be a non-nil pointer")
+	}
+
+	srcVal := reflect.ValueOf(src)
+
+	if !srcVal.IsValid() {
+		return errors.New("src should not be nil")
+	}
+
+	target := dstVal.Elem()
+
+	if srcVal.Type() == dstVal.Type() {
+		if srcVal.IsNil() {
+			return errors.New("src should not be nil")
+		}
+
+		srcVal = srcVal.Elem()
+	}
+
+	if srcVal.Type() != target.Type() {
+		return fmt.Errorf("expected %s but got %s", target.Type().String(), srcVal.Type().String())
+	}
+
+	target.Set(newCopier(opts).copy(srcVal))
+	return nil
+}
+
+func (c *copier) deepCopy(src reflect.Value) reflect.Value {
+	typ := src.Type()
+
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		key := visit{src.Pointer(), typ}
+		if copied, exists := c.visited[key]; exists {
+			return copied
+		}
+
+		copied := reflect.New(typ.Elem())
+		c.visited[key] = copied
+		copied.Elem().Set(c.deepCopy(src.Elem()))
+		return copied
+	case reflect.Interface:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		copied := reflect.New(typ).Elem()
+		copied.Set(c.deepCopy(src.Elem()))
+		return copied
+	case reflect.Struct:
+		if c.isOpaque(typ) {
+			return src
+		}
+
+		return c.copyStruct(src)
+	case reflect.Array:
+		copied := reflect.New(typ).Elem()
+		length := src.Len()
+
+		for i := 0; i < length; i++ {
+			copied.Index(i).Set(c.deepCopy(src.Index(i)))
+		}
+
+		return copied
+	case reflect.Slice:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		key := sliceVisit{src.Pointer(), src.Len(), typ}
+		if copied, exists := c.slices[key]; exists {
+			return copied
+		}
+
+		length := src.Len()
+		copied := reflect.MakeSlice(typ, length, src.Cap())
+		c.slices[key] = copied
+
+		for i := 0; i < length; i++ {
+			copied.Index(i).Set(c.deepCopy(src.Index(i)))
+		}
+
+		return copied
+	case reflect.Map:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		key := visit{src.Pointer(), typ}
+		if copied, exists := c.visited[key]; exists {
+			return copied
+		}
+
+		copied := reflect.MakeMapWithSize(typ, src.Len())
+		c.visited[key] = copied
+
+		iterator := src.MapRange()
+		for iterator.Next() {
+			copied.SetMapIndex(iterator.Key(), c.deepCopy(iterator.Value()))
+		}
+
+		return copied
+	case reflect.Chan:
+		if src.IsNil() || !c.options.freshChannels {
+			return src
+		}
+
+		key := visit{src.Pointer(), typ}
+		if copied, exists := c.visited[key]; exists {
+			return copied
+		}
+
+		copied := reflect.MakeChan(typ, src.Cap())
+		c.visited[key] = copied
+		return copied
+	default:
+		return src
+	}
+}
+
+func (c *copier) copyStruct(src reflect.Value) reflect.Value {
+	typ := src.Type()
+	copied := reflect.New(typ).Elem()
+
+	if !c.options.skipUnexported {
+		copied.Set(src)
+	}
+
+	for _, field := range c.fieldsOf(typ) {
+		if !field.exported && c.options.skipUnexported {
+			continue
+		}
+
+		fieldVal := copied.Field(field.index)
+		if !field.exported {
+			fieldVal = reflect.NewAt(fieldVal.Type(), unsafe.Pointer(fieldVal.UnsafeAddr())).Elem()
+		}
+
+		switch field.mode {
+		case ignoreCopyMode:
+			fieldVal.Set(reflect.Zero(fieldVal.Type()))
+		case shallowCopyMode:
+			if c.options.skipUnexported {
+				fieldVal.Set(src.Field(field.index))
+			}
+		default:
+			if c.options.skipUnexported {
+				fieldVal.Set(c.deepCopy(src.Field(field.index)))
+			} else {
+				fieldVal.Set(c.deepCopy(fieldVal))
+			}
+		}
+	}
+
+	return copied
+}
+
+func (c *copier) copy(src reflect.Value) reflect.Value {
+	c.packagePath = copyPackagePathOf(src)
+	return c.deepCopy(src)
+}
+
+func (c *copier) isOpaque(typ reflect.Type) bool {
+	if opaque, exists := c.opaque[typ]; exists {
+		return opaque
+	}
+
+	opaque := false
+
+	if typ.PkgPath() != "" && (isStandardPackage(typ.PkgPath()) || typ.PkgPath() != c.packagePath) {
+		for i := 0; i < typ.NumField(); i++ {
+			if !typ.Field(i).IsExported() {
+				opaque = true
+				break
+			}
+		}
+	}
+
+	c.opaque[typ] = opaque
+	return opaque
+}
+
+func copyPackagePathOf(val reflect.Value) string {
+	for val.Kind() == reflect.Interface && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if !val.IsValid() {
+		return ""
+	}
+
+	typ := val.Type()
+
+	for typ.Name() == "" {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+			typ = typ.Elem()
+		default:
+			return ""
+		}
+	}
+
+	return typ.PkgPath()
+}
+
+func isStandardPackage(path string) bool {
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		path = path[:i]
+	}
+
+	return !strings.Contains(path, ".")
+}
+
+func (c *copier) fieldsOf(typ reflect.Type) []copyField {
+	if fields, exists := c.fields[typ]; exists {
+		return fields
+	}
+
+	structType := ToStruct(typeOf(reflect.PtrTo(typ), typ, nil, nil))
+	fields := make([]copyField, 0, structType.NumField())
+
+	for _, field := range structType.Fields() {
+		mode := deepCopyMode
+
+		if tag, exists := field.Tags().Find("copy"); exists {
+			switch tag.Primary() {
+			case "-":
+				mode = ignoreCopyMode
+			case "shallow":
+				mode = shallowCopyMode
+			}
+		}
+
+		fields = append(fields, copyField{
+			index:    field.Index(),
+			exported: field.IsExported(),
+			mode:     mode,
+		})
+	}
+
+	c.fields[typ] = fields
+	return fields
+}
@@ -0,0 +1,151 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type TestCopyAddress struct {
+	City string
+}
+
+type TestCopyPerson struct {
+	Name      string
+	Address   *TestCopyAddress
+	Backup    *TestCopyAddress
+	Friends   []*TestCopyPerson
+	Scores    map[string][]int
+	Extra     any
+	Matrix    [2][]int
+	Events    chan int
+	Callback  func() string
+	Shared    *TestCopyAddress `copy:"shallow"`
+	Ignored   *TestCopyAddress `copy:"-"`
+	secret    *TestCopyAddress
+	createdAt int
+}
+
+func TestClone(t *testing.T) {
+	address := &TestCopyAddress{City: "Istanbul"}
+	person := &TestCopyPerson{
+		Name:      "anyName",
+		Address:   address,
+		Backup:    address,
+		Scores:    map[string][]int{"math": {1, 2}},
+		Extra:     TestCopyAddress{City: "Ankara"},
+		Matrix:    [2][]int{{1}, {2}},
+		Events:    make(chan int, 3),
+		Callback:  func() string { return "anyValue" },
+		Shared:    address,
+		Ignored:   address,
+		secret:    address,
+		createdAt: 7,
+	}
+	person.Friends = []*TestCopyPerson{person}
+
+	cloned := Clone(person)
+
+	assert.NotSame(t, person, cloned)
+	assert.Equal(t, "anyName", cloned.Name)
+
+	assert.NotSame(t, address, cloned.Address)
+	assert.Equal(t, "Istanbul", cloned.Address.City)
+	assert.Same(t, cloned.Address, cloned.Backup)
+
+	assert.Len(t, cloned.Friends, 1)
+	assert.Same(t, cloned, cloned.Friends[0])
+
+	assert.Equal(t, []int{1, 2}, cloned.Scores["math"])
+	cloned.Scores["math"][0] = 5
+	assert.Equal(t, 1, person.Scores["math"][0])
+
+	assert.Equal(t, TestCopyAddress{City: "Ankara"}, cloned.Extra)
+
+	cloned.Matrix[0][0] = 9
+	assert.Equal(t, 1, person.Matrix[0][0])
+
+	assert.Equal(t, person.Events, cloned.Events)
+	assert.Equal(t, "anyValue", cloned.Callback())
+
+	assert.Same(t, address, cloned.Shared)
+	assert.Nil(t, cloned.Ignored)
+
+	assert.Same(t, cloned.Address, cloned.secret)
+	assert.Equal(t, 7, cloned.createdAt)
+
+	cloned = Clone(person, FreshChannels(), SkipUnexported())
+	assert.NotNil(t, cloned.Events)
+	assert.NotEqual(t, person.Events, cloned.Events)
+	assert.Equal(t, 3, cap(cloned.Events))
+	assert.Equal(t, "anyValue", cloned.Callback())
+	assert.Nil(t, cloned.secret)
+	assert.Equal(t, 0, cloned.createdAt)
+	assert.Same(t, address, cloned.Shared)
+	assert.Nil(t, cloned.Ignored)
+
+	var value any = []string{"a"}
+	clonedValue := Clone(value)
+	assert.Equal(t, []string{"a"}, clonedValue)
+
+	var empty any
+	assert.Nil(t, Clone(empty))
+}
+
+func TestDeepCopy(t *testing.T) {
+	src := TestCopyPerson{
+		Name:    "anyName",
+		Address: &TestCopyAddress{City: "Istanbul"},
+	}
+
+	dst := TestCopyPerson{}
+	err := DeepCopy(&dst, src)
+	assert.Nil(t, err)
+	assert.Equal(t, "anyName", dst.Name)
+	assert.NotSame(t, src.Address, dst.Address)
+	assert.Equal(t, "Istanbul", dst.Address.City)
+
+	dst = TestCopyPerson{}
+	err = DeepCopy(&dst, &src)
+	assert.Nil(t, err)
+	assert.Equal(t, "anyName", dst.Name)
+
+	err = DeepCopy(dst, &src)
+	assert.EqualError(t, err, "dst should be a non-nil pointer")
+
+	err = DeepCopy(&dst, nil)
+	assert.EqualError(t, err, "src should not be nil")
+
+	err = DeepCopy(&dst, TestCopyAddress{})
+	assert.EqualError(t, err, "expected reflector.TestCopyPerson but got reflector.TestCopyAddress")
+}
+
+type TestCopyEvent struct {
+	Name string
+	At   time.Time
+	Next *time.Time
+}
+
+func TestClone_StandardLibraryStructs(t *testing.T) {
+	location := time.FixedZone("TRT", 3*60*60)
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, location)
+	event := TestCopyEvent{Name: "anyName", At: at, Next: &at}
+
+	cloned := Clone(event)
+	assert.True(t, cloned.At == event.At)
+	assert.Same(t, event.At.Location(), cloned.At.Location())
+	assert.NotSame(t, event.Next, cloned.Next)
+	assert.True(t, *cloned.Next == at)
+
+	cloned = Clone(event, SkipUnexported())
+	assert.True(t, cloned.At == event.At)
+
+	clonedTime := Clone(at)
+	assert.True(t, clonedTime == at)
+	assert.Same(t, location, clonedTime.Location())
+
+	dst := TestCopyEvent{}
+	err := DeepCopy(&dst, &event)
+	assert.Nil(t, err)
+	assert.True(t, dst.At == event.At)
+}
@@ -4,14 +4,23 @@ import (
 	"errors"
 	"reflect"
 	"strings"
+	"sync"
+	"unsafe"
 )
 
 type Custom interface {
 	Type
 	Underlying() Type
-	Methods() []Function
+	Methods() []Method
+	Method(index int) (Method, bool)
+	MethodByName(name string) (Method, bool)
 	NumMethod() int
+	ValueMethods() []Method
+	PointerMethods() []Method
 	Implements(i Interface) bool
+	ImplementsByValue(i Interface) bool
+	ImplementsByPointer(i Interface) bool
+	Implementation(i Interface) ImplementationKind
 }
 
 type customType struct {
@@ -19,6 +28,9 @@ type customType struct {
 	reflectType    reflect.Type
 	reflectValue   *reflect.Value
 	underlyingType Type
+
+	underlyingOnce sync.Once
+	underlying     Type
 }
 
 func (c *customType) Name() string {
@@ -81,6 +93,18 @@ func (c *customType) ReflectValue() *reflect.Value {
 	return c.reflectValue
 }
 
+func (c *customType) Kind() Kind {
+	return KindCustom
+}
+
+func (c *customType) UnderlyingKind() Kind {
+	if c.underlyingType == nil {
+		return KindInvalid
+	}
+
+	return c.underlyingType.UnderlyingKind()
+}
+
 func (c *customType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -132,44 +156,141 @@ func (c *customType) Convert(typ Type) (Value, error) {
 }
 
 func (c *customType) Underlying() Type {
-	return nil
+	c.underlyingOnce.Do(func() {
+		reflectType := underlyingReflectTypeOf(c.reflectType)
+
+		if c.reflectValue == nil {
+			c.underlying = typeOf(reflect.PtrTo(reflectType), reflectType, nil, nil)
+			return
+		}
+
+		val := underlyingValueOf(*c.reflectValue, reflectType)
+		c.underlying = typeOf(reflect.PtrTo(reflectType), reflectType, &val, nil)
+	})
+
+	return c.underlying
 }
 
-func (c *customType) Methods() []Function {
-	functions := make([]Function, 0)
+func (c *customType) Methods() []Method {
+	return methodsOf(c, c.methodSetType())
+}
 
-	reflectType := c.reflectType
+func (c *customType) Method(index int) (Method, bool) {
+	methodSet := metadataOf(c.methodSetType()).methodSet()
 
-	if c.Parent() != nil {
-		reflectType = c.Parent().ReflectType()
+	if index < 0 || index >= len(methodSet) {
+		return nil, false
 	}
 
-	numMethod := reflectType.NumMethod()
-	for i := 0; i < numMethod; i++ {
-		function := reflectType.Method(i)
-		functions = append(functions, &functionType{
-			name:        function.Name,
-			pkgPath:     function.PkgPath,
-			isExported:  function.IsExported(),
-			reflectType: function.Type,
-		})
+	return &methodType{
+		parent:        c,
+		reflectMethod: methodSet[index],
+	}, true
+}
+
+func (c *customType) MethodByName(name string) (Method, bool) {
+	method, exists := metadataOf(c.methodSetType()).methodByName(name)
+
+	if !exists {
+		return nil, false
 	}
 
-	return functions
+	return &methodType{
+		parent:        c,
+		reflectMethod: method,
+	}, true
 }
 
 func (c *customType) NumMethod() int {
-	if c.Parent() != nil {
-		return c.Parent().ReflectType().NumMethod()
-	}
+	return c.methodSetType().NumMethod()
+}
+
+func (c *customType) ValueMethods() []Method {
+	return methodsOf(c, c.reflectType)
+}
+
+func (c *customType) PointerMethods() []Method {
+	return methodsOf(c, reflect.PtrTo(c.reflectType))
+}
 
-	return c.reflectType.NumMethod()
+func (c *customType) methodSetType() reflect.Type {
+	return reflect.PtrTo(c.reflectType)
 }
 
 func (c *customType) Implements(i Interface) bool {
-	if c.Parent() != nil {
-		return c.Parent().ReflectType().Implements(i.ReflectType())
+	return implementsOf(c.Parent(), c.reflectType, i)
+}
+
+func (c *customType) ImplementsByValue(i Interface) bool {
+	return c.Implementation(i) == ImplementationBoth
+}
+
+func (c *customType) ImplementsByPointer(i Interface) bool {
+	return c.Implementation(i) != ImplementationNone
+}
+
+func (c *customType) Implementation(i Interface) ImplementationKind {
+	return implementationOf(c.reflectType, i)
+}
+
+var basicReflectTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:          reflect.TypeOf(false),
+	reflect.Int:           reflect.TypeOf(int(0)),
+	reflect.Int8:          reflect.TypeOf(int8(0)),
+	reflect.Int16:         reflect.TypeOf(int16(0)),
+	reflect.Int32:         reflect.TypeOf(int32(0)),
+	reflect.Int64:         reflect.TypeOf(int64(0)),
+	reflect.Uint:          reflect.TypeOf(uint(0)),
+	reflect.Uint8:         reflect.TypeOf(uint8(0)),
+	reflect.Uint16:        reflect.TypeOf(uint16(0)),
+	reflect.Uint32:        reflect.TypeOf(uint32(0)),
+	reflect.Uint64:        reflect.TypeOf(uint64(0)),
+	reflect.Uintptr:       reflect.TypeOf(uintptr(0)),
+	reflect.Float32:       reflect.TypeOf(float32(0)),
+	reflect.Float64:       reflect.TypeOf(float64(0)),
+	reflect.Complex64:     reflect.TypeOf(complex64(0)),
+	reflect.Complex128:    reflect.TypeOf(complex128(0)),
+	reflect.String:        reflect.TypeOf(""),
+	reflect.UnsafePointer: unsafePointerReflectType,
+}
+
+func underlyingReflectTypeOf(typ reflect.Type) reflect.Type {
+	if basicType, exists := basicReflectTypes[typ.Kind()]; exists {
+		return basicType
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return reflect.PtrTo(typ.Elem())
+	case reflect.Slice:
+		return reflect.SliceOf(typ.Elem())
+	case reflect.Array:
+		return reflect.ArrayOf(typ.Len(), typ.Elem())
+	case reflect.Map:
+		return reflect.MapOf(typ.Key(), typ.Elem())
+	case reflect.Chan:
+		return reflect.ChanOf(typ.ChanDir(), typ.Elem())
+	case reflect.Func:
+		in := make([]reflect.Type, typ.NumIn())
+		for i := range in {
+			in[i] = typ.In(i)
+		}
+
+		out := make([]reflect.Type, typ.NumOut())
+		for i := range out {
+			out[i] = typ.Out(i)
+		}
+
+		return reflect.FuncOf(in, out, typ.IsVariadic())
+	default:
+		return typ
+	}
+}
+
+func underlyingValueOf(val reflect.Value, typ reflect.Type) reflect.Value {
+	if val.CanSet() {
+		return reflect.NewAt(typ, unsafe.Pointer(val.UnsafeAddr())).Elem()
 	}
 
-	return c.reflectType.Implements(i.ReflectType())
+	return val.Convert(typ)
 }
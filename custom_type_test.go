@@ -0,0 +1,223 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestCelsius float64
+
+type TestIDs []int
+
+type TestLabels map[string]string
+
+type TestCustomHolder struct {
+	Temperature TestCelsius
+	IDs         TestIDs
+	label       TestLabels
+}
+
+func TestCustomType_Underlying(t *testing.T) {
+	typ := ToCustom(TypeOf[TestCelsius]())
+	assert.NotNil(t, typ)
+
+	underlying := typ.Underlying()
+	assert.True(t, IsFloat(underlying))
+	assert.Equal(t, "float64", underlying.Name())
+	assert.False(t, underlying.HasValue())
+	assert.Same(t, underlying, typ.Underlying())
+
+	assert.True(t, IsSlice(ToCustom(TypeOf[TestIDs]()).Underlying()))
+	assert.Equal(t, "map[string]string", ToCustom(TypeOf[TestLabels]()).Underlying().Name())
+}
+
+func TestCustomType_UnderlyingFloatValue(t *testing.T) {
+	temperature := TestCelsius(21.5)
+	typ := ToCustom(ToPointer(TypeOfAny(&temperature)).Elem())
+
+	floatType := ToFloat(typ.Underlying())
+	assert.NotNil(t, floatType)
+
+	val, err := floatType.FloatValue()
+	assert.Nil(t, err)
+	assert.Equal(t, 21.5, val)
+
+	assert.Nil(t, floatType.SetFloatValue(30))
+	assert.Equal(t, TestCelsius(30), temperature)
+
+	current, err := typ.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, TestCelsius(30), current)
+}
+
+func TestCustomType_UnderlyingSliceOperations(t *testing.T) {
+	holder := &TestCustomHolder{IDs: TestIDs{1, 2}}
+	structType := ToStruct(ToPointer(TypeOfAny(holder)).Elem())
+
+	field, exists := structType.FieldByName("IDs")
+	assert.True(t, exists)
+
+	sliceType := ToSlice(ToCustom(field.Type()).Underlying())
+	assert.NotNil(t, sliceType)
+
+	item, err := sliceType.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, item)
+
+	assert.Nil(t, sliceType.Set(0, 10))
+	assert.Equal(t, TestIDs{10, 2}, holder.IDs)
+
+	appended, err := sliceType.Append(3)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{10, 2, 3}, appended)
+
+	assert.Nil(t, sliceType.SetValue(appended))
+	assert.Equal(t, TestIDs{10, 2, 3}, holder.IDs)
+}
+
+func TestCustomType_UnderlyingOfReadOnlyValue(t *testing.T) {
+	holder := &TestCustomHolder{label: TestLabels{"env": "test"}}
+	structType := ToStruct(ToPointer(TypeOfAny(holder)).Elem())
+
+	field, exists := structType.FieldByName("label")
+	assert.True(t, exists)
+
+	mapType := ToMap(ToCustom(field.Type()).Underlying())
+	assert.NotNil(t, mapType)
+	assert.False(t, mapType.CanSet())
+
+	length, err := mapType.Len()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, length)
+
+	value := TestCelsius(5)
+	floatType := ToFloat(ToCustom(TypeOfAny(value)).Underlying())
+	assert.False(t, floatType.CanSet())
+
+	val, err := floatType.FloatValue()
+	assert.Nil(t, err)
+	assert.Equal(t, 5.0, val)
+	assert.NotNil(t, floatType.SetFloatValue(1))
+}
+
+type TestCounter int
+
+func (c TestCounter) Doubled() int {
+	return int(c) * 2
+}
+
+func (c *TestCounter) Add(delta int) {
+	*c += TestCounter(delta)
+}
+
+type TestCounterHolder struct {
+	Counter TestCounter
+	Pointer *TestCounter
+	hidden  TestCounter
+}
+
+func TestCustomType_Methods(t *testing.T) {
+	typ := ToCustom(TypeOf[TestCounter]())
+	assert.Equal(t, 2, typ.NumMethod())
+
+	methods := typ.Methods()
+	assert.Len(t, methods, 2)
+	assert.Equal(t, "Add", methods[0].Name())
+	assert.Equal(t, "Doubled", methods[1].Name())
+	assert.True(t, methods[0].IsExported())
+
+	method, exists := typ.Method(1)
+	assert.True(t, exists)
+	assert.Equal(t, "Doubled", method.Name())
+	assert.Equal(t, 0, method.NumParameter())
+	assert.Equal(t, 1, method.NumResult())
+	assert.Equal(t, typ, method.Parent())
+
+	_, exists = typ.Method(2)
+	assert.False(t, exists)
+
+	method, exists = typ.MethodByName("Add")
+	assert.True(t, exists)
+	assert.Equal(t, 1, method.NumParameter())
+	assert.Equal(t, "int", method.Parameters()[0].Name())
+
+	_, exists = typ.MethodByName("Missing")
+	assert.False(t, exists)
+
+	_, err := method.Invoke(1)
+	assert.NotNil(t, err)
+	assert.Equal(t, "value reference is nil", err.Error())
+}
+
+func TestCustomType_InvokeMethods(t *testing.T) {
+	counter := TestCounter(2)
+	typ := ToCustom(ToPointer(TypeOfAny(&counter)).Elem())
+
+	doubled, exists := typ.MethodByName("Doubled")
+	assert.True(t, exists)
+
+	results, err := doubled.Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{4}, results)
+
+	add, exists := typ.MethodByName("Add")
+	assert.True(t, exists)
+
+	_, err = add.Invoke(3)
+	assert.Nil(t, err)
+	assert.Equal(t, TestCounter(5), counter)
+
+	_, err = add.Invoke()
+	assert.NotNil(t, err)
+
+	results, err = doubled.Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{10}, results)
+}
+
+func TestCustomType_InvokeMethodsOnCopy(t *testing.T) {
+	counter := TestCounter(2)
+	typ := ToCustom(TypeOfAny(counter))
+
+	add, exists := typ.MethodByName("Add")
+	assert.True(t, exists)
+
+	_, err := add.Invoke(3)
+	assert.Nil(t, err)
+	assert.Equal(t, TestCounter(2), counter)
+
+	doubled, exists := typ.MethodByName("Doubled")
+	assert.True(t, exists)
+
+	results, err := doubled.Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{4}, results)
+}
+
+func TestCustomType_InvokeMethodsOnFields(t *testing.T) {
+	pointer := TestCounter(7)
+	holder := &TestCounterHolder{Counter: 1, Pointer: &pointer, hidden: 3}
+	structType := ToStruct(ToPointer(TypeOfAny(holder)).Elem())
+
+	field, _ := structType.FieldByName("Counter")
+	add, _ := ToCustom(field.Type()).MethodByName("Add")
+
+	_, err := add.Invoke(4)
+	assert.Nil(t, err)
+	assert.Equal(t, TestCounter(5), holder.Counter)
+
+	field, _ = structType.FieldByName("Pointer")
+	add, _ = ToCustom(ToPointer(field.Type()).Elem()).MethodByName("Add")
+
+	_, err = add.Invoke(1)
+	assert.Nil(t, err)
+	assert.Equal(t, TestCounter(8), pointer)
+
+	field, _ = structType.FieldByName("hidden")
+	add, _ = ToCustom(field.Type()).MethodByName("Add")
+
+	_, err = add.Invoke(1)
+	assert.NotNil(t, err)
+	assert.Equal(t, "value cannot be accessed", err.Error())
+	assert.Equal(t, TestCounter(3), holder.hidden)
+}
@@ -0,0 +1,424 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type DecodeOption func(options *decodeOptions)
+
+type decodeOptions struct {
+	tagKey        string
+	weakTyping    bool
+	squash        bool
+	errorOnUnused bool
+	unusedKeys    *[]string
+}
+
+func DecodeTagKey(key string) DecodeOption {
+	return func(options *decodeOptions) {
+		options.tagKey = key
+	}
+}
+
+func WeakTyping() DecodeOption {
+	return func(options *decodeOptions) {
+		options.weakTyping = true
+	}
+}
+
+func SquashEmbedded() DecodeOption {
+	return func(options *decodeOptions) {
+		options.squash = true
+	}
+}
+
+func ErrorOnUnused() DecodeOption {
+	return func(options *decodeOptions) {
+		options.errorOnUnused = true
+	}
+}
+
+func UnusedKeys(keys *[]string) DecodeOption {
+	return func(options *decodeOptions) {
+		options.unusedKeys = keys
+	}
+}
+
+type DecodeError struct {
+	Path   string
+	Reason string
+}
+
+func (e *DecodeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("decode: %s", e.Reason)
+	}
+
+	return fmt.Sprintf("decode '%s': %s", e.Path, e.Reason)
+}
+
+type decoder struct {
+	options decodeOptions
+	unused  []string
+}
+
+func Decode(input map[string]any, out any, opts ...DecodeOption) error {
+	outVal := reflect.ValueOf(out)
+
+	if outVal.Kind() != reflect.Ptr || outVal.IsNil() {
+		return errors.New("out should be a non-nil pointer")
+	}
+
+	if input == nil {
+		return nil
+	}
+
+	d := &decoder{
+		options: decodeOptions{
+			tagKey: "json",
+		},
+		unused: make([]string, 0),
+	}
+
+	for _, opt := range opts {
+		opt(&d.options)
+	}
+
+	if err := d.decode("", reflect.ValueOf(input), outVal.Elem()); err != nil {
+		return err
+	}
+
+	sort.Strings(d.unused)
+
+	if d.options.unusedKeys != nil {
+		*d.options.unusedKeys = d.unused
+	}
+
+	if d.options.errorOnUnused && len(d.unused) != 0 {
+		return &DecodeError{Reason: fmt.Sprintf("unused keys: %s", strings.Join(d.unused, ", "))}
+	}
+
+	return nil
+}
+
+func (d *decoder) decode(path string, input reflect.Value, out reflect.Value) error {
+	for input.Kind() == reflect.Interface && !input.IsNil() {
+		input = input.Elem()
+	}
+
+	if !input.IsValid() || ((input.Kind() == reflect.Ptr || input.Kind() == reflect.Map || input.Kind() == reflect.Slice || input.Kind() == reflect.Interface) && input.IsNil()) {
+		out.Set(reflect.Zero(out.Type()))
+		return nil
+	}
+
+	if input.Type().AssignableTo(out.Type()) && input.Kind() != reflect.Map && input.Kind() != reflect.Slice {
+		out.Set(input)
+		return nil
+	}
+
+	switch out.Kind() {
+	case reflect.Ptr:
+		if out.IsNil() {
+			out.Set(reflect.New(out.Type().Elem()))
+		}
+
+		if input.Kind() == reflect.Ptr {
+			input = input.Elem()
+		}
+
+		return d.decode(path, input, out.Elem())
+	case reflect.Interface:
+		if !input.Type().Implements(out.Type()) {
+			return d.errorf(path, "expected %s but got %s", out.Type().String(), input.Type().String())
+		}
+
+		out.Set(input)
+		return nil
+	case reflect.Struct:
+		if input.Kind() != reflect.Map || input.Type().Key().Kind() != reflect.String {
+			return d.errorf(path, "expected a map but got %s", input.Type().String())
+		}
+
+		used := make(map[string]bool)
+
+		if err := d.decodeStruct(path, input, out, used); err != nil {
+			return err
+		}
+
+		for _, key := range input.MapKeys() {
+			if !used[key.String()] {
+				d.unused = append(d.unused, joinPath(path, key.String()))
+			}
+		}
+
+		return nil
+	case reflect.Map:
+		return d.decodeMap(path, input, out)
+	case reflect.Slice, reflect.Array:
+		return d.decodeSlice(path, input, out)
+	default:
+		return d.decodeScalar(path, input, out)
+	}
+}
+
+func (d *decoder) decodeStruct(path string, input reflect.Value, out reflect.Value, used map[string]bool) error {
+	typ := out.Type()
+	structType := ToStruct(typeOf(reflect.PtrTo(typ), typ, &out, nil))
+
+	for _, structField := range structType.Fields() {
+		if !structField.IsExported() {
+			continue
+		}
+
+		name := structField.Name()
+		squash := d.options.squash && structField.IsAnonymous()
+
+		if d.options.tagKey != "" {
+			if tag, exists := structField.Tags().Find(d.options.tagKey); exists {
+				if tag.Primary() == "-" {
+					continue
+				}
+
+				if tag.Primary() != "" {
+					name = tag.Primary()
+				}
+
+				squash = squash || tag.HasOption("squash")
+			}
+		}
+
+		fieldVal, err := structField.(*field).walk(structField.IndexPath(), false)
+		if err != nil {
+			return d.fail(joinPath(path, structField.Name()), err)
+		}
+
+		if squash {
+			if err = d.decodeSquashed(path, input, fieldVal, used); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		key, exists := mapKeyOf(input, name)
+		if !exists {
+			continue
+		}
+
+		used[key.String()] = true
+
+		decoded := reflect.New(fieldVal.Type()).Elem()
+		decoded.Set(fieldVal)
+
+		if err = d.decode(joinPath(path, structField.Name()), input.MapIndex(key), decoded); err != nil {
+			return err
+		}
+
+		if err = structField.SetValue(interfaceOf(decoded)); err != nil {
+			return d.fail(joinPath(path, structField.Name()), err)
+		}
+	}
+
+	return nil
+}
+
+func (d *decoder) decodeSquashed(path string, input reflect.Value, out reflect.Value, used map[string]bool) error {
+	if out.Kind() == reflect.Ptr && out.Type().Elem().Kind() == reflect.Struct {
+		if out.IsNil() {
+			out.Set(reflect.New(out.Type().Elem()))
+		}
+
+		out = out.Elem()
+	}
+
+	if out.Kind() != reflect.Struct {
+		return d.errorf(path, "%s cannot be squashed", out.Type().String())
+	}
+
+	return d.decodeStruct(path, input, out, used)
+}
+
+func (d *decoder) decodeMap(path string, input reflect.Value, out reflect.Value) error {
+	if input.Kind() != reflect.Map {
+		return d.errorf(path, "expected a map but got %s", input.Type().String())
+	}
+
+	typ := out.Type()
+
+	if out.IsNil() {
+		out.Set(reflect.MakeMapWithSize(typ, input.Len()))
+	}
+
+	keys := input.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+
+	for _, key := range keys {
+		elemPath := path + "[" + formatKey(key) + "]"
+
+		converted, err := convertMapKey(key, typ.Key())
+		if err != nil {
+			return d.fail(elemPath, err)
+		}
+
+		elem := reflect.New(typ.Elem()).Elem()
+		if existing := out.MapIndex(converted); existing.IsValid() {
+			elem.Set(existing)
+		}
+
+		if err = d.decode(elemPath, input.MapIndex(key), elem); err != nil {
+			return err
+		}
+
+		out.SetMapIndex(converted, elem)
+	}
+
+	return nil
+}
+
+func (d *decoder) decodeSlice(path string, input reflect.Value, out reflect.Value) error {
+	if input.Kind() != reflect.Slice && input.Kind() != reflect.Array {
+		if !d.options.weakTyping {
+			return d.errorf(path, "expected a slice but got %s", input.Type().String())
+		}
+
+		wrapped := reflect.MakeSlice(reflect.SliceOf(input.Type()), 1, 1)
+		wrapped.Index(0).Set(input)
+		input = wrapped
+	}
+
+	length := input.Len()
+	decoded := reflect.New(out.Type()).Elem()
+
+	if out.Kind() == reflect.Array {
+		if length > out.Len() {
+			return d.errorf(path, "%d elements exceed %s", length, out.Type().String())
+		}
+	} else {
+		decoded.Set(reflect.MakeSlice(out.Type(), length, length))
+	}
+
+	for i := 0; i < length; i++ {
+		if err := d.decode(path+"["+strconv.Itoa(i)+"]", input.Index(i), decoded.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	out.Set(decoded)
+	return nil
+}
+
+func (d *decoder) decodeScalar(path string, input reflect.Value, out reflect.Value) error {
+	if d.options.weakTyping {
+		input = weakValueOf(input, out.Type())
+	}
+
+	converted, err := convertReflectValue(input, out.Type())
+	if err != nil {
+		return d.fail(path, err)
+	}
+
+	out.Set(converted)
+	return nil
+}
+
+func (d *decoder) errorf(path string, format string, args ...any) error {
+	return &DecodeError{
+		Path:   path,
+		Reason: fmt.Sprintf(format, args...),
+	}
+}
+
+func (d *decoder) fail(path string, err error) error {
+	return &DecodeError{
+		Path:   path,
+		Reason: err.Error(),
+	}
+}
+
+func mapKeyOf(input reflect.Value, name string) (reflect.Value, bool) {
+	key := reflect.ValueOf(name).Convert(input.Type().Key())
+
+	if input.MapIndex(key).IsValid() {
+		return key, true
+	}
+
+	for _, candidate := range input.MapKeys() {
+		if strings.EqualFold(candidate.String(), name) {
+			return candidate, true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func weakValueOf(input reflect.Value, typ reflect.Type) reflect.Value {
+	switch typ.Kind() {
+	case reflect.Bool:
+		switch {
+		case input.Kind() == reflect.String:
+			if input.String() == "" {
+				return reflect.ValueOf(false)
+			}
+
+			if val, err := strconv.ParseBool(input.String()); err == nil {
+				return reflect.ValueOf(val)
+			}
+		case input.CanInt():
+			return reflect.ValueOf(input.Int() != 0)
+		case input.CanUint():
+			return reflect.ValueOf(input.Uint() != 0)
+		case input.CanFloat():
+			return reflect.ValueOf(input.Float() != 0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		switch input.Kind() {
+		case reflect.String:
+			text := strings.TrimSpace(input.String())
+			if text == "" {
+				return reflect.ValueOf(0)
+			}
+
+			if val, err := strconv.ParseInt(text, 0, 64); err == nil {
+				return reflect.ValueOf(val)
+			}
+
+			if val, err := strconv.ParseUint(text, 0, 64); err == nil {
+				return reflect.ValueOf(val)
+			}
+
+			if val, err := strconv.ParseFloat(text, 64); err == nil {
+				return reflect.ValueOf(val)
+			}
+		case reflect.Bool:
+			if input.Bool() {
+				return reflect.ValueOf(1)
+			}
+
+			return reflect.ValueOf(0)
+		}
+	case reflect.String:
+		switch {
+		case input.Kind() == reflect.Bool:
+			return reflect.ValueOf(strconv.FormatBool(input.Bool()))
+		case input.CanInt():
+			return reflect.ValueOf(strconv.FormatInt(input.Int(), 10))
+		case input.CanUint():
+			return reflect.ValueOf(strconv.FormatUint(input.Uint(), 10))
+		case input.CanFloat():
+			return reflect.ValueOf(strconv.FormatFloat(input.Float(), 'f', -1, input.Type().Bits()))
+		case input.Kind() == reflect.Slice && input.Type().Elem().Kind() == reflect.Uint8:
+			return reflect.ValueOf(string(input.Bytes()))
+		}
+	}
+
+	return input
+}
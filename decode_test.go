@@ -0,0 +1,245 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestDecodeBase struct {
+	ID      int
+	Created string
+}
+
+type TestDecodeAddress struct {
+	City string `map:"city"`
+	Zip  int    `map:"zip"`
+}
+
+type TestDecodeLevel string
+
+type TestDecodeUser struct {
+	TestDecodeBase
+	Name      string                       `map:"name"`
+	Age       int                          `map:"age"`
+	Active    bool                         `map:"active"`
+	Score     float32                      `map:"score"`
+	Level     TestDecodeLevel              `map:"level"`
+	Nickname  *string                      `map:"nickname"`
+	Address   TestDecodeAddress            `map:"address"`
+	Previous  []*TestDecodeAddress         `map:"previous"`
+	Labels    map[string]int               `map:"labels"`
+	Indexed   map[int]TestDecodeAddress    `map:"indexed"`
+	Extra     any                          `map:"extra"`
+	Ignored   string                       `map:"-"`
+	Nested    map[string]map[string]string `map:"nested"`
+	Codes     [2]string                    `map:"codes"`
+	secret    string
+	Aliases   []string           `map:"aliases"`
+	Reference *TestDecodeAddress `map:"reference"`
+}
+
+func TestDecode(t *testing.T) {
+	user := TestDecodeUser{}
+
+	err := Decode(map[string]any{
+		"name":     "anna",
+		"age":      float64(42),
+		"active":   true,
+		"score":    9.5,
+		"level":    "senior",
+		"nickname": "ann",
+		"address": map[string]any{
+			"city": "Berlin",
+			"zip":  10115,
+		},
+		"previous": []any{
+			map[string]any{"city": "Hamburg"},
+			nil,
+		},
+		"labels":  map[string]any{"a": 1, "b": int64(2)},
+		"indexed": map[string]any{"3": map[string]any{"city": "Paris"}},
+		"extra":   []any{"x", 1},
+		"Ignored": "value",
+		"nested":  map[string]any{"outer": map[string]any{"inner": "value"}},
+		"codes":   []any{"a", "b"},
+		"secret":  "value",
+		"aliases": []string{"a", "b"},
+		"reference": &TestDecodeAddress{
+			City: "Rome",
+		},
+		"testdecodebase": map[string]any{"ID": 7},
+	}, &user, DecodeTagKey("map"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "anna", user.Name)
+	assert.Equal(t, 42, user.Age)
+	assert.True(t, user.Active)
+	assert.Equal(t, float32(9.5), user.Score)
+	assert.Equal(t, TestDecodeLevel("senior"), user.Level)
+	assert.Equal(t, "ann", *user.Nickname)
+	assert.Equal(t, TestDecodeAddress{City: "Berlin", Zip: 10115}, user.Address)
+	assert.Equal(t, []*TestDecodeAddress{{City: "Hamburg"}, nil}, user.Previous)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, user.Labels)
+	assert.Equal(t, map[int]TestDecodeAddress{3: {City: "Paris"}}, user.Indexed)
+	assert.Equal(t, []any{"x", 1}, user.Extra)
+	assert.Empty(t, user.Ignored)
+	assert.Equal(t, map[string]map[string]string{"outer": {"inner": "value"}}, user.Nested)
+	assert.Equal(t, [2]string{"a", "b"}, user.Codes)
+	assert.Empty(t, user.secret)
+	assert.Equal(t, []string{"a", "b"}, user.Aliases)
+	assert.Equal(t, &TestDecodeAddress{City: "Rome"}, user.Reference)
+	assert.Equal(t, TestDecodeBase{ID: 7}, user.TestDecodeBase)
+}
+
+func TestDecode_FieldNamesAreCaseInsensitive(t *testing.T) {
+	address := TestDecodeAddress{}
+
+	err := Decode(map[string]any{"CITY": "Berlin", "Zip": 10115}, &address)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TestDecodeAddress{City: "Berlin", Zip: 10115}, address)
+}
+
+func TestDecode_KeepsExistingValues(t *testing.T) {
+	user := TestDecodeUser{
+		Name:    "anna",
+		Address: TestDecodeAddress{City: "Berlin", Zip: 10115},
+		Labels:  map[string]int{"a": 1},
+	}
+
+	err := Decode(map[string]any{
+		"address": map[string]any{"zip": 20095},
+		"labels":  map[string]any{"b": 2},
+	}, &user, DecodeTagKey("map"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "anna", user.Name)
+	assert.Equal(t, TestDecodeAddress{City: "Berlin", Zip: 20095}, user.Address)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, user.Labels)
+}
+
+func TestDecode_NilInputKeepsOutput(t *testing.T) {
+	address := TestDecodeAddress{City: "Berlin", Zip: 10115}
+
+	err := Decode(nil, &address)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TestDecodeAddress{City: "Berlin", Zip: 10115}, address)
+}
+
+type TestDecodeAccount struct {
+	UserName string            `json:"user_name"`
+	Roles    []string          `json:"roles,omitempty"`
+	Limits   map[string]uint16 `json:"limits"`
+}
+
+func TestDecode_RoundTripsStructToMap(t *testing.T) {
+	account := TestDecodeAccount{
+		UserName: "anna",
+		Roles:    []string{"admin"},
+		Limits:   map[string]uint16{"requests": 10},
+	}
+
+	encoded, err := StructToMap(account)
+	assert.Nil(t, err)
+
+	decoded := TestDecodeAccount{}
+	err = Decode(encoded, &decoded)
+
+	assert.Nil(t, err)
+	assert.Equal(t, account, decoded)
+}
+
+func TestDecode_WeakTyping(t *testing.T) {
+	user := TestDecodeUser{}
+
+	err := Decode(map[string]any{
+		"name":    42,
+		"age":     "42",
+		"active":  1,
+		"score":   "1.5",
+		"aliases": "single",
+		"address": map[string]any{"zip": "0x10"},
+	}, &user, DecodeTagKey("map"), WeakTyping())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "42", user.Name)
+	assert.Equal(t, 42, user.Age)
+	assert.True(t, user.Active)
+	assert.Equal(t, float32(1.5), user.Score)
+	assert.Equal(t, []string{"single"}, user.Aliases)
+	assert.Equal(t, 16, user.Address.Zip)
+
+	err = Decode(map[string]any{"age": "42"}, &user, DecodeTagKey("map"))
+	assert.NotNil(t, err)
+	assert.Equal(t, "decode 'Age': cannot convert string to int", err.Error())
+}
+
+func TestDecode_SquashEmbedded(t *testing.T) {
+	user := TestDecodeUser{}
+
+	err := Decode(map[string]any{
+		"id":      7,
+		"created": "today",
+		"name":    "anna",
+	}, &user, DecodeTagKey("map"), SquashEmbedded())
+
+	assert.Nil(t, err)
+	assert.Equal(t, TestDecodeBase{ID: 7, Created: "today"}, user.TestDecodeBase)
+	assert.Equal(t, "anna", user.Name)
+
+	type squashed struct {
+		*TestDecodeBase `map:",squash"`
+		Name            string
+	}
+
+	value := squashed{}
+	err = Decode(map[string]any{"id": 8, "name": "bob"}, &value, DecodeTagKey("map"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &TestDecodeBase{ID: 8}, value.TestDecodeBase)
+	assert.Equal(t, "bob", value.Name)
+}
+
+func TestDecode_UnusedKeys(t *testing.T) {
+	user := TestDecodeUser{}
+	unused := make([]string, 0)
+
+	err := Decode(map[string]any{
+		"name":    "anna",
+		"unknown": true,
+		"address": map[string]any{"city": "Berlin", "street": "Main"},
+	}, &user, DecodeTagKey("map"), UnusedKeys(&unused))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Address.street", "unknown"}, unused)
+
+	err = Decode(map[string]any{"unknown": true}, &user, DecodeTagKey("map"), ErrorOnUnused())
+	assert.NotNil(t, err)
+	assert.Equal(t, "decode: unused keys: unknown", err.Error())
+}
+
+func TestDecode_ErrorsIncludeFieldPath(t *testing.T) {
+	user := TestDecodeUser{}
+
+	testCases := []struct {
+		input    map[string]any
+		expected string
+	}{
+		{map[string]any{"address": map[string]any{"zip": "x"}}, "decode 'Address.Zip': cannot convert string to int"},
+		{map[string]any{"previous": []any{nil, map[string]any{"zip": true}}}, "decode 'Previous[1].Zip': cannot convert bool to int"},
+		{map[string]any{"indexed": map[string]any{"x": map[string]any{}}}, "decode 'Indexed[\"x\"]': key 'x' cannot be converted to int"},
+		{map[string]any{"labels": map[string]any{"a": 1.5}}, "decode 'Labels[\"a\"]': 1.5 overflows int"},
+		{map[string]any{"address": "Berlin"}, "decode 'Address': expected a map but got string"},
+		{map[string]any{"aliases": "a"}, "decode 'Aliases': expected a slice but got string"},
+		{map[string]any{"codes": []any{"a", "b", "c"}}, "decode 'Codes': 3 elements exceed [2]string"},
+	}
+
+	for _, testCase := range testCases {
+		err := Decode(testCase.input, &user, DecodeTagKey("map"))
+		assert.NotNil(t, err)
+		assert.Equal(t, testCase.expected, err.Error())
+	}
+
+	assert.Equal(t, "out should be a non-nil pointer", Decode(map[string]any{}, user).Error())
+}
@@ -0,0 +1,487 @@
+package reflector
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"unsafe"
+)
+
+type DiffKind int
+
+const (
+	DiffChanged DiffKind = iota
+	DiffAdded
+	DiffRemoved
+	DiffTypeMismatch
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case DiffAdded:
+		return "added"
+	case DiffRemoved:
+		return "removed"
+	case DiffTypeMismatch:
+		return "type-mismatch"
+	default:
+		return "changed"
+	}
+}
+
+type Difference interface {
+	Path() string
+	Kind() DiffKind
+	Old() any
+	New() any
+	String() string
+}
+
+type difference struct {
+	path   string
+	kind   DiffKind
+	oldVal any
+	newVal any
+}
+
+func (d *difference) Path() string {
+	return d.path
+}
+
+func (d *difference) Kind() DiffKind {
+	return d.kind
+}
+
+func (d *difference) Old() any {
+	return d.oldVal
+}
+
+func (d *difference) New() any {
+	return d.newVal
+}
+
+func (d *difference) String() string {
+	path := d.path
+	if path == "" {
+		path = "<root>"
+	}
+
+	switch d.kind {
+	case DiffAdded:
+		return fmt.Sprintf("%s: added %v", path, d.newVal)
+	case DiffRemoved:
+		return fmt.Sprintf("%s: removed %v", path, d.oldVal)
+	case DiffTypeMismatch:
+		return fmt.Sprintf("%s: type mismatch %T -> %T", path, d.oldVal, d.newVal)
+	default:
+		return fmt.Sprintf("%s: changed %v -> %v", path, d.oldVal, d.newVal)
+	}
+}
+
+type CompareOption func(options *compareOptions)
+
+type compareOptions struct {
+	ignoredFields  map[string]struct{}
+	ignoredTags    map[string]string
+	nilEqualsEmpty bool
+	floatTolerance float64
+	unorderedSlice bool
+}
+
+func IgnoreFields(names ...string) CompareOption {
+	return func(options *compareOptions) {
+		for _, name := range names {
+			options.ignoredFields[name] = struct{}{}
+		}
+	}
+}
+
+func IgnoreFieldsWithTag(key string, value string) CompareOption {
+	return func(options *compareOptions) {
+		options.ignoredTags[key] = value
+	}
+}
+
+func NilEqualsEmpty() CompareOption {
+	return func(options *compareOptions) {
+		options.nilEqualsEmpty = true
+	}
+}
+
+func FloatTolerance(tolerance float64) CompareOption {
+	return func(options *compareOptions) {
+		options.floatTolerance = math.Abs(tolerance)
+	}
+}
+
+func UnorderedSlices() CompareOption {
+	return func(options *compareOptions) {
+		options.unorderedSlice = true
+	}
+}
+
+type visitPair struct {
+	x    uintptr
+	y    uintptr
+	xLen int
+	yLen int
+	typ  reflect.Type
+}
+
+type differ struct {
+	options     compareOptions
+	firstOnly   bool
+	differences []Difference
+	visited     map[visitPair]bool
+	ignored     map[reflect.Type][]bool
+}
+
+func newDiffer(opts []CompareOption, firstOnly bool) *differ {
+	d := &differ{
+		options: compareOptions{
+			ignoredFields: make(map[string]struct{}),
+			ignoredTags:   make(map[string]string),
+		},
+		firstOnly:   firstOnly,
+		differences: make([]Difference, 0),
+		visited:     make(map[visitPair]bool),
+		ignored:     make(map[reflect.Type][]bool),
+	}
+
+	for _, opt := range opts {
+		opt(&d.options)
+	}
+
+	return d
+}
+
+func Equal(a, b any, opts ...CompareOption) bool {
+	d := newDiffer(opts, true)
+	d.diff(addressableValueOf(a), addressableValueOf(b), "")
+	return len(d.differences) == 0
+}
+
+func Diff(a, b any, opts ...CompareOption) []Difference {
+	d := newDiffer(opts, false)
+	d.diff(addressableValueOf(a), addressableValueOf(b), "")
+	return d.differences
+}
+
+func addressableValueOf(obj any) reflect.Value {
+	val := reflect.ValueOf(obj)
+
+	if !val.IsValid() {
+		return val
+	}
+
+	addressable := reflect.New(val.Type()).Elem()
+	addressable.Set(val)
+	return addressable
+}
+
+func interfaceOf(val reflect.Value) any {
+	if !val.IsValid() {
+		return nil
+	}
+
+	if val.CanInterface() {
+		return val.Interface()
+	}
+
+	if val.CanAddr() {
+		return reflect.NewAt(val.Type(), unsafe.Pointer(val.UnsafeAddr())).Elem().Interface()
+	}
+
+	return fmt.Sprint(val)
+}
+
+func (d *differ) done() bool {
+	return d.firstOnly && len(d.differences) != 0
+}
+
+func (d *differ) report(path string, kind DiffKind, x, y reflect.Value) {
+	d.differences = append(d.differences, &difference{
+		path:   path,
+		kind:   kind,
+		oldVal: interfaceOf(x),
+		newVal: interfaceOf(y),
+	})
+}
+
+func (d *differ) diff(x, y reflect.Value, path string) {
+	if d.done() {
+		return
+	}
+
+	if !x.IsValid() || !y.IsValid() {
+		if x.IsValid() {
+			d.report(path, DiffRemoved, x, y)
+		} else if y.IsValid() {
+			d.report(path, DiffAdded, x, y)
+		}
+
+		return
+	}
+
+	if x.Type() != y.Type() {
+		d.report(path, DiffTypeMismatch, x, y)
+		return
+	}
+
+	switch x.Kind() {
+	case reflect.Ptr:
+		if x.IsNil() || y.IsNil() {
+			if x.IsNil() != y.IsNil() {
+				d.report(path, DiffChanged, x, y)
+			}
+
+			return
+		}
+
+		if x.Pointer() == y.Pointer() || d.markVisited(x, y) {
+			return
+		}
+
+		d.diff(x.Elem(), y.Elem(), path)
+	case reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			if x.IsNil() != y.IsNil() {
+				d.report(path, DiffChanged, x, y)
+			}
+
+			return
+		}
+
+		d.diff(x.Elem(), y.Elem(), path)
+	case reflect.Struct:
+		ignored := d.ignoredFieldsOf(x.Type())
+		numField := x.NumField()
+
+		for i := 0; i < numField; i++ {
+			if d.done() {
+				return
+			}
+
+			name := x.Type().Field(i).Name
+			fieldPath := joinPath(path, name)
+
+			if ignored[i] {
+				continue
+			}
+
+			if _, exists := d.options.ignoredFields[fieldPath]; exists {
+				continue
+			}
+
+			d.diff(x.Field(i), y.Field(i), fieldPath)
+		}
+	case reflect.Array:
+		length := x.Len()
+
+		for i := 0; i < length; i++ {
+			d.diff(x.Index(i), y.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+	case reflect.Slice:
+		if d.nilMismatch(x, y, path) {
+			return
+		}
+
+		if x.Len() == y.Len() && (x.Len() == 0 || x.Pointer() == y.Pointer()) {
+			return
+		}
+
+		if d.markVisited(x, y) {
+			return
+		}
+
+		if d.options.unorderedSlice {
+			d.diffUnordered(x, y, path)
+			return
+		}
+
+		for i := 0; i < x.Len() || i < y.Len(); i++ {
+			elemPath := path + "[" + strconv.Itoa(i) + "]"
+
+			if i >= y.Len() {
+				d.report(elemPath, DiffRemoved, x.Index(i), reflect.Value{})
+			} else if i >= x.Len() {
+				d.report(elemPath, DiffAdded, reflect.Value{}, y.Index(i))
+			} else {
+				d.diff(x.Index(i), y.Index(i), elemPath)
+			}
+
+			if d.done() {
+				return
+			}
+		}
+	case reflect.Map:
+		if d.nilMismatch(x, y, path) {
+			return
+		}
+
+		if x.Pointer() == y.Pointer() || d.markVisited(x, y) {
+			return
+		}
+
+		keys := x.MapKeys()
+		for _, key := range y.MapKeys() {
+			if !x.MapIndex(key).IsValid() {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+
+		for _, key := range keys {
+			d.diff(x.MapIndex(key), y.MapIndex(key), path+"["+formatKey(key)+"]")
+		}
+	case reflect.Float32, reflect.Float64:
+		if !d.equalFloat(x.Float(), y.Float()) {
+			d.report(path, DiffChanged, x, y)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		if !d.equalFloat(real(x.Complex()), real(y.Complex())) || !d.equalFloat(imag(x.Complex()), imag(y.Complex())) {
+			d.report(path, DiffChanged, x, y)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if x.Int() != y.Int() {
+			d.report(path, DiffChanged, x, y)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if x.Uint() != y.Uint() {
+			d.report(path, DiffChanged, x, y)
+		}
+	case reflect.String:
+		if x.String() != y.String() {
+			d.report(path, DiffChanged, x, y)
+		}
+	case reflect.Bool:
+		if x.Bool() != y.Bool() {
+			d.report(path, DiffChanged, x, y)
+		}
+	case reflect.Func:
+		if !x.IsNil() || !y.IsNil() {
+			d.report(path, DiffChanged, x, y)
+		}
+	default:
+		if x.Pointer() != y.Pointer() {
+			d.report(path, DiffChanged, x, y)
+		}
+	}
+}
+
+func (d *differ) diffUnordered(x, y reflect.Value, path string) {
+	matched := make([]bool, y.Len())
+
+	for i := 0; i < x.Len(); i++ {
+		found := false
+
+		for j := 0; j < y.Len(); j++ {
+			if matched[j] {
+				continue
+			}
+
+			elemDiffer := newDiffer(nil, true)
+			elemDiffer.options = d.options
+			elemDiffer.diff(x.Index(i), y.Index(j), "")
+
+			if len(elemDiffer.differences) == 0 {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			d.report(path+"["+strconv.Itoa(i)+"]", DiffRemoved, x.Index(i), reflect.Value{})
+		}
+
+		if d.done() {
+			return
+		}
+	}
+
+	for j := 0; j < y.Len(); j++ {
+		if !matched[j] {
+			d.report(path+"["+strconv.Itoa(j)+"]", DiffAdded, reflect.Value{}, y.Index(j))
+		}
+
+		if d.done() {
+			return
+		}
+	}
+}
+
+func (d *differ) nilMismatch(x, y reflect.Value, path string) bool {
+	if x.IsNil() == y.IsNil() {
+		return x.IsNil()
+	}
+
+	if d.options.nilEqualsEmpty && x.Len() == 0 && y.Len() == 0 {
+		return true
+	}
+
+	d.report(path, DiffChanged, x, y)
+	return true
+}
+
+func (d *differ) markVisited(x, y reflect.Value) bool {
+	key := visitPair{x: x.Pointer(), y: y.Pointer(), typ: x.Type()}
+
+	if x.Kind() == reflect.Slice {
+		key.xLen = x.Len()
+		key.yLen = y.Len()
+	}
+
+	if d.visited[key] {
+		return true
+	}
+
+	d.visited[key] = true
+	return false
+}
+
+func (d *differ) equalFloat(x, y float64) bool {
+	if x == y {
+		return true
+	}
+
+	return math.Abs(x-y) <= d.options.floatTolerance
+}
+
+func (d *differ) ignoredFieldsOf(typ reflect.Type) []bool {
+	if ignored, exists := d.ignored[typ]; exists {
+		return ignored
+	}
+
+	numField := typ.NumField()
+	ignored := make([]bool, numField)
+
+	for i := 0; i < numField; i++ {
+		structField := typ.Field(i)
+
+		if _, exists := d.options.ignoredFields[structField.Name]; exists {
+			ignored[i] = true
+			continue
+		}
+
+		if len(d.options.ignoredTags) == 0 {
+			continue
+		}
+
+		tags, _, _ := parseStructTag(structField.Name, string(structField.Tag))
+
+		for key, value := range d.options.ignoredTags {
+			if tag, exists := tags.Find(key); exists && tag.Primary() == value {
+				ignored[i] = true
+			}
+		}
+	}
+
+	d.ignored[typ] = ignored
+	return ignored
+}
@@ -0,0 +1,165 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestDiffAddress struct {
+	City string
+	Zip  int
+}
+
+type TestDiffUser struct {
+	Name      string
+	Age       int
+	Score     float64
+	Address   *TestDiffAddress
+	Tags      []string
+	Meta      map[string]any
+	UpdatedAt int64 `diff:"-"`
+	Next      *TestDiffUser
+	version   int
+}
+
+func TestEqual(t *testing.T) {
+	first := TestDiffUser{
+		Name:    "anyName",
+		Address: &TestDiffAddress{City: "Istanbul"},
+		Tags:    []string{"a", "b"},
+		Meta:    map[string]any{"key": 1},
+	}
+	second := first
+	second.Address = &TestDiffAddress{City: "Istanbul"}
+
+	assert.True(t, Equal(first, second))
+	assert.True(t, Equal(&first, &second))
+	assert.True(t, Equal(nil, nil))
+	assert.False(t, Equal(first, nil))
+	assert.False(t, Equal(first, &second))
+
+	second.version = 1
+	assert.False(t, Equal(first, second))
+	assert.True(t, Equal(first, second, IgnoreFields("version")))
+
+	second.UpdatedAt = 10
+	assert.False(t, Equal(first, second, IgnoreFields("version")))
+	assert.True(t, Equal(first, second, IgnoreFields("version"), IgnoreFieldsWithTag("diff", "-")))
+
+	first.Tags = nil
+	second = first
+	second.Tags = []string{}
+	assert.False(t, Equal(first, second))
+	assert.True(t, Equal(first, second, NilEqualsEmpty()))
+
+	second.Score = 0.0001
+	assert.False(t, Equal(first, second, NilEqualsEmpty()))
+	assert.True(t, Equal(first, second, NilEqualsEmpty(), FloatTolerance(0.001)))
+
+	assert.False(t, Equal([]int{1, 2, 3}, []int{3, 1, 2}))
+	assert.True(t, Equal([]int{1, 2, 3}, []int{3, 1, 2}, UnorderedSlices()))
+	assert.False(t, Equal([]int{1, 2, 2}, []int{2, 1, 1}, UnorderedSlices()))
+}
+
+func TestEqualCycles(t *testing.T) {
+	first := &TestDiffUser{Name: "anyName"}
+	first.Next = first
+
+	second := &TestDiffUser{Name: "anyName"}
+	second.Next = second
+
+	assert.True(t, Equal(first, second))
+
+	second.Next = &TestDiffUser{Name: "anotherName", Next: second}
+	assert.False(t, Equal(first, second))
+}
+
+type TestDiffSubSlices struct {
+	A []int
+	B []int
+}
+
+func TestEqualSubSlicesOfSameBackingArray(t *testing.T) {
+	a := []int{1, 2}
+	b := []int{1, 2, 3}
+
+	first := TestDiffSubSlices{A: a, B: a[:1]}
+	second := TestDiffSubSlices{A: b[:2], B: b[:2]}
+
+	assert.False(t, Equal(first, second))
+
+	differences := Diff(first, second)
+	assert.Len(t, differences, 1)
+	assert.Equal(t, "B[1]: added 2", differences[0].String())
+}
+
+func TestDiff(t *testing.T) {
+	first := TestDiffUser{
+		Name:    "anyName",
+		Age:     30,
+		Address: &TestDiffAddress{City: "Istanbul", Zip: 34000},
+		Tags:    []string{"a", "b", "c"},
+		Meta:    map[string]any{"removed": 1, "changed": "x", "mismatch": 1},
+	}
+
+	second := TestDiffUser{
+		Name:    "anotherName",
+		Age:     30,
+		Address: &TestDiffAddress{City: "Ankara", Zip: 34000},
+		Tags:    []string{"a", "d"},
+		Meta:    map[string]any{"added": true, "changed": "y", "mismatch": "1"},
+		version: 2,
+	}
+
+	differences := Diff(first, second)
+
+	result := make([]string, 0)
+	for _, difference := range differences {
+		result = append(result, difference.String())
+	}
+
+	assert.Equal(t, []string{
+		"Name: changed anyName -> anotherName",
+		"Address.City: changed Istanbul -> Ankara",
+		"Tags[1]: changed b -> d",
+		"Tags[2]: removed c",
+		"Meta[\"added\"]: added true",
+		"Meta[\"changed\"]: changed x -> y",
+		"Meta[\"mismatch\"]: type mismatch int -> string",
+		"Meta[\"removed\"]: removed 1",
+		"version: changed 0 -> 2",
+	}, result)
+
+	difference := differences[0]
+	assert.Equal(t, "Name", difference.Path())
+	assert.Equal(t, DiffChanged, difference.Kind())
+	assert.Equal(t, "changed", difference.Kind().String())
+	assert.Equal(t, "anyName", difference.Old())
+	assert.Equal(t, "anotherName", difference.New())
+
+	difference = differences[3]
+	assert.Equal(t, DiffRemoved, difference.Kind())
+	assert.Equal(t, "c", difference.Old())
+	assert.Nil(t, difference.New())
+
+	difference = differences[6]
+	assert.Equal(t, DiffTypeMismatch, difference.Kind())
+	assert.Equal(t, "type-mismatch", difference.Kind().String())
+
+	differences = Diff(first, second, IgnoreFields("Meta", "Address.City", "version"), UnorderedSlices())
+
+	result = make([]string, 0)
+	for _, difference := range differences {
+		result = append(result, difference.String())
+	}
+
+	assert.Equal(t, []string{
+		"Name: changed anyName -> anotherName",
+		"Tags[1]: removed b",
+		"Tags[2]: removed c",
+		"Tags[1]: added d",
+	}, result)
+
+	assert.Empty(t, Diff(first, first))
+	assert.Equal(t, "<root>: type mismatch int -> string", Diff(1, "1")[0].String())
+}
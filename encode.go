@@ -0,0 +1,238 @@
+package reflector
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+type EncodeOption func(options *encodeOptions)
+
+type encodeOptions struct {
+	tagKey string
+}
+
+func EncodeTagKey(key string) EncodeOption {
+	return func(options *encodeOptions) {
+		options.tagKey = key
+	}
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+type encoder struct {
+	options encodeOptions
+	visited map[visit]bool
+}
+
+func StructToMap(obj any, opts ...EncodeOption) (map[string]any, error) {
+	e := &encoder{
+		options: encodeOptions{
+			tagKey: "json",
+		},
+		visited: make(map[visit]bool),
+	}
+
+	for _, opt := range opts {
+		opt(&e.options)
+	}
+
+	val := reflect.ValueOf(obj)
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		e.visited[visit{val.Pointer(), val.Type()}] = true
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return nil, errors.New("obj should be a struct or a non-nil pointer to a struct")
+	}
+
+	result := make(map[string]any)
+	if err := e.encodeStruct("", addressableValueOf(val.Interface()), result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (e *encoder) encodeStruct(path string, val reflect.Value, result map[string]any) error {
+	typ := val.Type()
+	structType := ToStruct(typeOf(reflect.PtrTo(typ), typ, &val, nil))
+
+	for _, structField := range structType.VisibleFieldsByTag(e.options.tagKey) {
+		inline := false
+		omitEmpty := false
+
+		if tag, exists := structField.Tags().Find(e.options.tagKey); exists {
+			inline = tag.HasOption("inline") || tag.HasOption("squash")
+			omitEmpty = tag.HasOption("omitempty")
+		}
+
+		fieldVal, err := structField.(*field).walk(structField.IndexPath(), false)
+		if err != nil {
+			continue
+		}
+
+		fieldPath := joinPath(path, structField.Name())
+
+		if inline {
+			for fieldVal.Kind() == reflect.Ptr && !fieldVal.IsNil() {
+				fieldVal = fieldVal.Elem()
+			}
+
+			if fieldVal.Kind() == reflect.Struct {
+				if err = e.encodeStruct(fieldPath, fieldVal, result); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if fieldVal.Kind() == reflect.Ptr {
+				continue
+			}
+		}
+
+		if !structField.IsExported() {
+			continue
+		}
+
+		if omitEmpty && isEmptyValue(fieldVal) {
+			continue
+		}
+
+		encoded, err := e.encodeValue(fieldPath, fieldVal)
+		if err != nil {
+			return err
+		}
+
+		result[structField.VisibleName()] = encoded
+	}
+
+	return nil
+}
+
+func (e *encoder) encodeValue(path string, val reflect.Value) (any, error) {
+	if !val.IsValid() {
+		return nil, nil
+	}
+
+	if isSelfEncoding(val.Type()) {
+		if (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) && val.IsNil() {
+			return nil, nil
+		}
+
+		return interfaceOf(val), nil
+	}
+
+	key, tracked := visitOf(val)
+	if tracked {
+		if e.visited[key] {
+			return nil, fmt.Errorf("cycle detected at '%s'", path)
+		}
+
+		e.visited[key] = true
+		defer delete(e.visited, key)
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if val.IsNil() {
+			return nil, nil
+		}
+
+		return e.encodeValue(path, val.Elem())
+	case reflect.Struct:
+		result := make(map[string]any)
+
+		if err := e.encodeStruct(path, val, result); err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	case reflect.Slice, reflect.Array:
+		if val.Kind() == reflect.Slice && val.IsNil() {
+			return nil, nil
+		}
+
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			return interfaceOf(val), nil
+		}
+
+		length := val.Len()
+		result := make([]any, length)
+
+		for i := 0; i < length; i++ {
+			encoded, err := e.encodeValue(path+"["+strconv.Itoa(i)+"]", val.Index(i))
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = encoded
+		}
+
+		return result, nil
+	case reflect.Map:
+		if val.IsNil() {
+			return nil, nil
+		}
+
+		result := make(map[string]any, val.Len())
+		iterator := val.MapRange()
+
+		for iterator.Next() {
+			key := iterator.Key()
+			name := fmt.Sprint(interfaceOf(key))
+
+			if key.Kind() == reflect.String {
+				name = key.String()
+			}
+
+			encoded, err := e.encodeValue(path+"["+formatKey(key)+"]", iterator.Value())
+			if err != nil {
+				return nil, err
+			}
+
+			result[name] = encoded
+		}
+
+		return result, nil
+	default:
+		return interfaceOf(val), nil
+	}
+}
+
+func isSelfEncoding(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Interface {
+		return false
+	}
+
+	return typ.Implements(jsonMarshalerType) || typ.Implements(textMarshalerType) ||
+		reflect.PtrTo(typ).Implements(jsonMarshalerType) || reflect.PtrTo(typ).Implements(textMarshalerType)
+}
+
+func isEmptyValue(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return val.Len() == 0
+	case reflect.Bool:
+		return !val.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return val.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return val.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return val.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return val.IsNil()
+	}
+
+	return false
+}
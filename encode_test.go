@@ -0,0 +1,138 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type TestEncodeMeta struct {
+	CreatedBy string `json:"created_by" db:"created_by"`
+}
+
+type TestEncodeAudit struct {
+	Version int `json:"version" db:"version"`
+}
+
+type TestEncodeItem struct {
+	SKU      string  `json:"sku" db:"sku"`
+	Quantity uint16  `json:"quantity" db:"qty"`
+	Price    float32 `json:"price,omitempty" db:"price"`
+}
+
+type TestEncodeOrder struct {
+	TestEncodeMeta
+	Audit     TestEncodeAudit   `json:"audit" db:",inline"`
+	ID        int64             `json:"id" db:"order_id"`
+	Customer  *string           `json:"customer,omitempty" db:"customer"`
+	Items     []TestEncodeItem  `json:"items" db:"items"`
+	Refs      []*TestEncodeItem `json:"refs,omitempty" db:"-"`
+	Labels    map[int]string    `json:"labels" db:"labels"`
+	Data      []byte            `json:"data" db:"data"`
+	CreatedAt time.Time         `json:"created_at" db:"created_at"`
+	Extra     any               `json:"extra" db:"extra"`
+	Internal  string            `json:"-"`
+	Dash      string            `json:"-," db:"dash"`
+	NoTag     bool
+	hidden    string
+}
+
+type TestEncodeNode struct {
+	Name string          `json:"name"`
+	Next *TestEncodeNode `json:"next"`
+}
+
+func TestStructToMap(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	order := &TestEncodeOrder{
+		TestEncodeMeta: TestEncodeMeta{CreatedBy: "anna"},
+		Audit:          TestEncodeAudit{Version: 3},
+		ID:             42,
+		Items: []TestEncodeItem{
+			{SKU: "a", Quantity: 2, Price: 1.5},
+			{SKU: "b", Quantity: 1},
+		},
+		Labels:    map[int]string{1: "one"},
+		Data:      []byte("raw"),
+		CreatedAt: createdAt,
+		Extra:     &TestEncodeItem{SKU: "c"},
+		Internal:  "secret",
+		Dash:      "dash",
+		NoTag:     true,
+		hidden:    "hidden",
+	}
+
+	result, err := StructToMap(order)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{
+		"created_by": "anna",
+		"audit":      map[string]any{"version": 3},
+		"id":         int64(42),
+		"items": []any{
+			map[string]any{"sku": "a", "quantity": uint16(2), "price": float32(1.5)},
+			map[string]any{"sku": "b", "quantity": uint16(1)},
+		},
+		"labels":     map[string]any{"1": "one"},
+		"data":       []byte("raw"),
+		"created_at": createdAt,
+		"extra":      map[string]any{"sku": "c", "quantity": uint16(0)},
+		"-":          "dash",
+		"NoTag":      true,
+	}, result)
+}
+
+func TestStructToMap_WithCustomTagKey(t *testing.T) {
+	customer := "bob"
+	order := TestEncodeOrder{
+		TestEncodeMeta: TestEncodeMeta{CreatedBy: "anna"},
+		Audit:          TestEncodeAudit{Version: 3},
+		ID:             42,
+		Customer:       &customer,
+		Refs:           []*TestEncodeItem{{SKU: "x"}},
+	}
+
+	result, err := StructToMap(order, EncodeTagKey("db"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "anna", result["created_by"])
+	assert.Equal(t, 3, result["version"])
+	assert.Equal(t, int64(42), result["order_id"])
+	assert.Equal(t, "bob", result["customer"])
+	assert.Nil(t, result["items"])
+	assert.NotContains(t, result, "refs")
+	assert.NotContains(t, result, "Refs")
+	assert.Contains(t, result, "Internal")
+}
+
+func TestStructToMap_Errors(t *testing.T) {
+	_, err := StructToMap(42)
+	assert.NotNil(t, err)
+	assert.Equal(t, "obj should be a struct or a non-nil pointer to a struct", err.Error())
+
+	_, err = StructToMap((*TestEncodeOrder)(nil))
+	assert.NotNil(t, err)
+
+	node := &TestEncodeNode{Name: "first"}
+	node.Next = &TestEncodeNode{Name: "second", Next: node}
+
+	_, err = StructToMap(node)
+	assert.NotNil(t, err)
+	assert.Equal(t, "cycle detected at 'Next.Next'", err.Error())
+}
+
+type TestEncodeInner struct {
+	A int `json:"a"`
+}
+
+type TestEncodeShadowed struct {
+	A int `json:"a"`
+	TestEncodeInner
+}
+
+func TestStructToMap_ShallowerFieldWins(t *testing.T) {
+	result, err := StructToMap(TestEncodeShadowed{A: 1, TestEncodeInner: TestEncodeInner{A: 2}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"a": 1}, result)
+}
@@ -2,52 +2,109 @@ package reflector
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
-	"strconv"
 )
 
 type Field interface {
 	Name() string
+	VisibleName() string
 	Index() int
+	IndexPath() []int
 	IsExported() bool
 	IsAnonymous() bool
+	IsPromoted() bool
+	DeclaringType() Type
 	Type() Type
 	CanSet() bool
 	Value() (any, error)
 	SetValue(value any) error
+	SetValueAllocating(value any) error
 	Tags() Tags
+	ValidateTags() []*TagError
 	ReflectStructField() reflect.StructField
 }
 
 type field struct {
-	index       int
-	structType  *structType
-	structField reflect.StructField
+	metadata   *fieldMetadata
+	structType *structType
 }
 
 func (f *field) Name() string {
-	return f.structField.Name
+	return f.metadata.structField.Name
+}
+
+func (f *field) VisibleName() string {
+	if f.metadata.name != "" {
+		return f.metadata.name
+	}
+
+	return f.metadata.structField.Name
 }
 
 func (f *field) Index() int {
-	return f.index
+	return f.metadata.index[len(f.metadata.index)-1]
+}
+
+func (f *field) IndexPath() []int {
+	indexPath := make([]int, len(f.metadata.index))
+	copy(indexPath, f.metadata.index)
+	return indexPath
 }
 
 func (f *field) IsExported() bool {
-	return f.structField.IsExported()
+	return f.metadata.structField.IsExported()
 }
 
 func (f *field) IsAnonymous() bool {
-	return f.structField.Anonymous
+	return f.metadata.structField.Anonymous
+}
+
+func (f *field) IsPromoted() bool {
+	return len(f.metadata.index) > 1
+}
+
+func (f *field) DeclaringType() Type {
+	if !f.IsPromoted() {
+		return f.structType
+	}
+
+	declaringType := f.structType.reflectType
+	for _, index := range f.metadata.index[:len(f.metadata.index)-1] {
+		declaringType = declaringType.Field(index).Type
+
+		if declaringType.Kind() == reflect.Ptr {
+			declaringType = declaringType.Elem()
+		}
+	}
+
+	if f.structType.reflectValue == nil {
+		return typeOf(reflect.PtrTo(declaringType), declaringType, nil, nil)
+	}
+
+	val, err := f.walk(f.metadata.index[:len(f.metadata.index)-1], false)
+	if err != nil {
+		return typeOf(reflect.PtrTo(declaringType), declaringType, nil, nil)
+	}
+
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	return typeOf(reflect.PtrTo(declaringType), declaringType, &val, nil)
 }
 
 func (f *field) Type() Type {
 	if f.structType.reflectValue == nil {
-		return typeOf(nil, f.structField.Type, nil, f.structType)
+		return typeOf(nil, f.metadata.structField.Type, nil, f.structType)
 	}
 
-	v := f.structType.reflectValue.Field(f.index)
-	return typeOf(nil, f.structField.Type, &v, f.structType)
+	v, err := f.walk(f.metadata.index, false)
+	if err != nil {
+		return typeOf(nil, f.metadata.structField.Type, nil, f.structType)
+	}
+
+	return typeOf(nil, f.metadata.structField.Type, &v, f.structType)
 }
 
 func (f *field) CanSet() bool {
@@ -63,10 +120,23 @@ func (f *field) Value() (any, error) {
 		return nil, errors.New("the field is unexported")
 	}
 
-	return f.structType.reflectValue.Field(f.index).Interface(), nil
+	val, err := f.walk(f.metadata.index, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return val.Interface(), nil
 }
 
 func (f *field) SetValue(value any) error {
+	return f.setValue(value, false)
+}
+
+func (f *field) SetValueAllocating(value any) error {
+	return f.setValue(value, true)
+}
+
+func (f *field) setValue(value any, allocate bool) error {
 	if !f.CanSet() {
 		return errors.New("value cannot be set")
 	}
@@ -75,59 +145,55 @@ func (f *field) SetValue(value any) error {
 		return errors.New("the field is unexported")
 	}
 
-	f.structType.reflectValue.Field(f.index).Set(reflect.ValueOf(value))
+	val, err := f.walk(f.metadata.index, allocate)
+	if err != nil {
+		return err
+	}
+
+	val.Set(valueOrZero(value, val.Type()))
 	return nil
 }
 
-func (f *field) ReflectStructField() reflect.StructField {
-	return f.structField
-}
+func (f *field) walk(indexPath []int, allocate bool) (reflect.Value, error) {
+	val := *f.structType.reflectValue
 
-func (f *field) Tags() Tags {
-	fieldTags := make([]Tag, 0)
+	for i, index := range indexPath {
+		if i > 0 && val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				if !allocate {
+					return reflect.Value{}, fmt.Errorf("embedded pointer '%s' is nil", val.Type().Elem().Name())
+				}
 
-	tags := f.structField.Tag
-	for tags != "" {
-		i := 0
-		for i < len(tags) && tags[i] == ' ' {
-			i++
-		}
-		tags = tags[i:]
-		if tags == "" {
-			break
-		}
+				if !val.CanSet() {
+					return reflect.Value{}, fmt.Errorf("embedded pointer '%s' cannot be allocated", val.Type().Elem().Name())
+				}
 
-		i = 0
-		for i < len(tags) && tags[i] > ' ' && tags[i] != ':' && tags[i] != '"' && tags[i] != 0x7f {
-			i++
-		}
-		if i == 0 || i+1 >= len(tags) || tags[i] != ':' || tags[i+1] != '"' {
-			break
-		}
-		name := string(tags[:i])
-		tags = tags[i+1:]
-
-		i = 1
-		for i < len(tags) && tags[i] != '"' {
-			if tags[i] == '\\' {
-				i++
+				val.Set(reflect.New(val.Type().Elem()))
 			}
-			i++
-		}
-		if i >= len(tags) {
-			break
-		}
-		quotedValue := string(tags[:i+1])
-		tags = tags[i+1:]
 
-		value, err := strconv.Unquote(quotedValue)
-		if err != nil {
-			break
+			val = val.Elem()
 		}
 
-		fieldTag := &tag{name, value}
-		fieldTags = append(fieldTags, fieldTag)
+		val = val.Field(index)
 	}
 
-	return fieldTags
+	return val, nil
+}
+
+func (f *field) ReflectStructField() reflect.StructField {
+	return f.metadata.structField
+}
+
+func (f *field) Tags() Tags {
+	parsed := f.metadata.parseTags().tags
+	tags := make(Tags, len(parsed))
+	copy(tags, parsed)
+	return tags
+}
+
+func (f *field) ValidateTags() []*TagError {
+	parsed := f.metadata.parseTags().errors
+	errs := make([]*TagError, len(parsed))
+	copy(errs, parsed)
+	return errs
 }
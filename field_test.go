@@ -0,0 +1,126 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestPromotedInnerStruct struct {
+	InnerField string
+}
+
+type TestPromotedMiddleStruct struct {
+	*TestPromotedInnerStruct
+	MiddleField int
+}
+
+type TestPromotedOuterStruct struct {
+	OuterField bool
+	TestPromotedMiddleStruct
+}
+
+func TestFieldPromoted(t *testing.T) {
+	structType := ToStruct(TypeOf[TestPromotedOuterStruct]())
+
+	field, exists := structType.FieldByName("MiddleField")
+	assert.True(t, exists)
+	assert.True(t, field.IsPromoted())
+	assert.Equal(t, []int{1, 1}, field.IndexPath())
+	assert.Equal(t, 1, field.Index())
+	assert.Equal(t, "int", field.Type().Name())
+	assert.Equal(t, "TestPromotedMiddleStruct", field.DeclaringType().Name())
+
+	field, exists = structType.FieldByName("InnerField")
+	assert.True(t, exists)
+	assert.True(t, field.IsPromoted())
+	assert.Equal(t, []int{1, 0, 0}, field.IndexPath())
+	assert.Equal(t, "string", field.Type().Name())
+	assert.Equal(t, "TestPromotedInnerStruct", field.DeclaringType().Name())
+	assert.True(t, IsStruct(field.DeclaringType()))
+
+	field, exists = structType.FieldByName("OuterField")
+	assert.True(t, exists)
+	assert.False(t, field.IsPromoted())
+	assert.Equal(t, []int{0}, field.IndexPath())
+	assert.Equal(t, structType, field.DeclaringType())
+}
+
+func TestFieldPromotedValue(t *testing.T) {
+	outer := &TestPromotedOuterStruct{}
+	typ := TypeOfAny(outer)
+	structType := ToStruct(ToPointer(typ).Elem())
+
+	field, exists := structType.FieldByName("MiddleField")
+	assert.True(t, exists)
+
+	err := field.SetValue(42)
+	assert.Nil(t, err)
+	assert.Equal(t, 42, outer.MiddleField)
+
+	val, err := field.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, 42, val)
+
+	field, exists = structType.FieldByName("InnerField")
+	assert.True(t, exists)
+
+	val, err = field.Value()
+	assert.Nil(t, val)
+	assert.NotNil(t, err)
+
+	err = field.SetValue("anyTestValue")
+	assert.NotNil(t, err)
+	assert.Nil(t, outer.TestPromotedInnerStruct)
+
+	err = field.SetValueAllocating("anyTestValue")
+	assert.Nil(t, err)
+	assert.NotNil(t, outer.TestPromotedInnerStruct)
+	assert.Equal(t, "anyTestValue", outer.InnerField)
+
+	val, err = field.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "anyTestValue", val)
+
+	declaringType := field.DeclaringType()
+	assert.True(t, declaringType.HasValue())
+	val, err = declaringType.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, TestPromotedInnerStruct{InnerField: "anyTestValue"}, val)
+}
+
+func TestFieldByIndex(t *testing.T) {
+	outer := &TestPromotedOuterStruct{
+		TestPromotedMiddleStruct: TestPromotedMiddleStruct{
+			TestPromotedInnerStruct: &TestPromotedInnerStruct{
+				InnerField: "anyTestValue",
+			},
+		},
+	}
+	structType := ToStruct(ToPointer(TypeOfAny(outer)).Elem())
+
+	field, exists := structType.FieldByIndex([]int{1, 0, 0})
+	assert.True(t, exists)
+	assert.Equal(t, "InnerField", field.Name())
+	assert.True(t, field.IsPromoted())
+
+	val, err := field.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "anyTestValue", val)
+
+	field, exists = structType.FieldByIndex([]int{1})
+	assert.True(t, exists)
+	assert.Equal(t, "TestPromotedMiddleStruct", field.Name())
+	assert.False(t, field.IsPromoted())
+
+	field, exists = structType.FieldByIndex([]int{0, 0})
+	assert.False(t, exists)
+	assert.Nil(t, field)
+
+	field, exists = structType.FieldByIndex([]int{1, 5})
+	assert.False(t, exists)
+	assert.Nil(t, field)
+
+	field, exists = structType.FieldByIndex(nil)
+	assert.False(t, exists)
+	assert.Nil(t, field)
+}
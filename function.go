@@ -187,6 +187,14 @@ func (f *functionType) ReflectValue() *reflect.Value {
 	return f.reflectValue
 }
 
+func (f *functionType) Kind() Kind {
+	return KindFunction
+}
+
+func (f *functionType) UnderlyingKind() Kind {
+	return KindFunction
+}
+
 func (f *functionType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -240,14 +248,8 @@ func (f *functionType) IsExported() bool {
 }
 
 func (f *functionType) Parameters() []Type {
-	parameters := make([]Type, 0)
-	numIn := f.reflectType.NumIn()
-
-	for index := 0; index < numIn; index++ {
-		typ := f.reflectType.In(index)
-		parameters = append(parameters, typeOf(nil, typ, nil, nil))
-	}
-	return parameters
+	parameters, _ := metadataOf(f.reflectType).signature()
+	return append(make([]Type, 0, len(parameters)), parameters...)
 }
 
 func (f *functionType) NumParameter() int {
@@ -255,14 +257,8 @@ func (f *functionType) NumParameter() int {
 }
 
 func (f *functionType) Results() []Type {
-	results := make([]Type, 0)
-	numOut := f.reflectType.NumOut()
-
-	for index := 0; index < numOut; index++ {
-		typ := f.reflectType.Out(index)
-		results = append(results, typeOf(nil, typ, nil, nil))
-	}
-	return results
+	_, results := metadataOf(f.reflectType).signature()
+	return append(make([]Type, 0, len(results)), results...)
 }
 
 func (f *functionType) NumResult() int {
@@ -332,3 +332,21 @@ func TestTypeOfTestFunction(t *testing.T) {
 	assert.Equal(t, 25, outputs[0])
 	assert.Equal(t, "Function1", outputs[1].(error).Error())
 }
+
+type TestRecursiveFunc func(TestRecursiveFunc) TestRecursiveFunc
+
+func TestTypeOfFunction_RecursiveType(t *testing.T) {
+	typ := TypeOf[TestRecursiveFunc]()
+	assert.Equal(t, "TestRecursiveFunc", typ.Name())
+
+	function := ToFunction(ToCustom(typ).Underlying())
+	assert.NotNil(t, function)
+
+	assert.Len(t, function.Parameters(), 1)
+	assert.Equal(t, "TestRecursiveFunc", function.Parameters()[0].Name())
+	assert.Len(t, function.Results(), 1)
+	assert.Equal(t, "TestRecursiveFunc", function.Results()[0].Name())
+
+	parameter := ToFunction(ToCustom(function.Parameters()[0]).Underlying())
+	assert.Equal(t, "TestRecursiveFunc", parameter.Parameters()[0].Name())
+}
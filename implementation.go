@@ -0,0 +1,237 @@
+package reflector
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type ImplementationKind int
+
+const (
+	ImplementationNone ImplementationKind = iota
+	ImplementationPointer
+	ImplementationBoth
+)
+
+func (k ImplementationKind) String() string {
+	switch k {
+	case ImplementationPointer:
+		return "pointer"
+	case ImplementationBoth:
+		return "value-and-pointer"
+	default:
+		return "none"
+	}
+}
+
+func implementationOf(typ reflect.Type, i Interface) ImplementationKind {
+	if i == nil {
+		return ImplementationNone
+	}
+
+	if typ.Implements(i.ReflectType()) {
+		return ImplementationBoth
+	}
+
+	if reflect.PtrTo(typ).Implements(i.ReflectType()) {
+		return ImplementationPointer
+	}
+
+	return ImplementationNone
+}
+
+func implementsOf(parent Type, typ reflect.Type, i Interface) bool {
+	kind := implementationOf(typ, i)
+
+	if parent != nil && parent.ReflectType() == reflect.PtrTo(typ) {
+		return kind != ImplementationNone
+	}
+
+	return kind == ImplementationBoth
+}
+
+type Implementor struct {
+	Type Type
+	Kind ImplementationKind
+}
+
+func Implementations(iface Interface) []Implementor {
+	implementors := make([]Implementor, 0)
+
+	if iface == nil {
+		return implementors
+	}
+
+	for _, typ := range Types() {
+		if typ.ReflectType().Kind() == reflect.Interface {
+			continue
+		}
+
+		if kind := implementationOf(typ.ReflectType(), iface); kind != ImplementationNone {
+			implementors = append(implementors, Implementor{
+				Type: typ,
+				Kind: kind,
+			})
+		}
+	}
+
+	return implementors
+}
+
+func methodsOf(parent Type, methodSetType reflect.Type) []Method {
+	methodSet := metadataOf(methodSetType).methodSet()
+	methods := make([]Method, len(methodSet))
+
+	for i, method := range methodSet {
+		methods[i] = &methodType{
+			parent:        parent,
+			reflectMethod: method,
+		}
+	}
+
+	return methods
+}
+
+type MethodMismatch struct {
+	Name     string
+	Expected Function
+	Actual   Function
+}
+
+func newMethodMismatch(name string, expected reflect.Type, actual reflect.Type) *MethodMismatch {
+	return &MethodMismatch{
+		Name:     name,
+		Expected: ToFunction(unboundTypeOf(expected)),
+		Actual:   ToFunction(unboundTypeOf(actual)),
+	}
+}
+
+func (m *MethodMismatch) String() string {
+	return fmt.Sprintf("method %s has signature %s but expected %s", m.Name, m.Actual.ReflectType(), m.Expected.ReflectType())
+}
+
+type ImplementationReport struct {
+	Type            Type
+	Interface       Interface
+	Kind            ImplementationKind
+	Missing         []string
+	Mismatched      []*MethodMismatch
+	PointerReceiver []string
+}
+
+func CheckImplements(typ Type, iface Interface) *ImplementationReport {
+	if typ == nil || iface == nil {
+		return nil
+	}
+
+	reflectType := typ.ReflectType()
+	report := &ImplementationReport{
+		Type:            typ,
+		Interface:       iface,
+		Kind:            implementationOf(reflectType, iface),
+		Missing:         make([]string, 0),
+		Mismatched:      make([]*MethodMismatch, 0),
+		PointerReceiver: make([]string, 0),
+	}
+
+	unexported := make([]string, 0)
+
+	for _, expected := range metadataOf(iface.ReflectType()).methodSet() {
+		if expected.PkgPath != "" && reflectType.Kind() != reflect.Interface {
+			unexported = append(unexported, expected.Name)
+			continue
+		}
+
+		actual, exists := methodSignatureOf(reflectType, expected)
+
+		if exists {
+			if actual != expected.Type {
+				report.Mismatched = append(report.Mismatched, newMethodMismatch(expected.Name, expected.Type, actual))
+			}
+
+			continue
+		}
+
+		if reflectType.Kind() == reflect.Interface || reflectType.Kind() == reflect.Ptr {
+			report.Missing = append(report.Missing, expected.Name)
+			continue
+		}
+
+		actual, exists = methodSignatureOf(reflect.PtrTo(reflectType), expected)
+
+		if !exists {
+			report.Missing = append(report.Missing, expected.Name)
+		} else if actual != expected.Type {
+			report.Mismatched = append(report.Mismatched, newMethodMismatch(expected.Name, expected.Type, actual))
+		} else {
+			report.PointerReceiver = append(report.PointerReceiver, expected.Name)
+		}
+	}
+
+	switch report.Kind {
+	case ImplementationPointer:
+		if len(report.PointerReceiver) == 0 {
+			report.PointerReceiver = append(report.PointerReceiver, unexported...)
+		}
+	case ImplementationNone:
+		if len(report.Missing) == 0 && len(report.Mismatched) == 0 {
+			report.Missing = append(report.Missing, unexported...)
+		}
+	}
+
+	return report
+}
+
+func (r *ImplementationReport) Satisfied() bool {
+	return r.Kind == ImplementationBoth
+}
+
+func (r *ImplementationReport) String() string {
+	typeName := r.Type.ReflectType().String()
+	interfaceName := r.Interface.ReflectType().String()
+
+	if r.Satisfied() {
+		return fmt.Sprintf("%s implements %s", typeName, interfaceName)
+	}
+
+	reasons := make([]string, 0)
+
+	for _, name := range r.Missing {
+		reasons = append(reasons, fmt.Sprintf("missing method %s", name))
+	}
+
+	for _, mismatch := range r.Mismatched {
+		reasons = append(reasons, mismatch.String())
+	}
+
+	for _, name := range r.PointerReceiver {
+		reasons = append(reasons, fmt.Sprintf("method %s has pointer receiver", name))
+	}
+
+	return fmt.Sprintf("%s does not implement %s: %s", typeName, interfaceName, strings.Join(reasons, "; "))
+}
+
+func methodSignatureOf(typ reflect.Type, expected reflect.Method) (reflect.Type, bool) {
+	method, exists := typ.MethodByName(expected.Name)
+
+	if !exists || method.PkgPath != expected.PkgPath {
+		return nil, false
+	}
+
+	if typ.Kind() == reflect.Interface {
+		return method.Type, true
+	}
+
+	in := make([]reflect.Type, method.Type.NumIn()-1)
+	for i := range in {
+		in[i] = method.Type.In(i + 1)
+	}
+
+	out := make([]reflect.Type, method.Type.NumOut())
+	for i := range out {
+		out[i] = method.Type.Out(i)
+	}
+
+	return reflect.FuncOf(in, out, method.Type.IsVariadic()), true
+}
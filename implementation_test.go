@@ -0,0 +1,276 @@
+package reflector
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestDescriber interface {
+	Describe() string
+}
+
+type TestRenamer interface {
+	Rename(name string)
+}
+
+func TestStruct_ValueAndPointerMethods(t *testing.T) {
+	entity := TestMethodEntity{Name: "anna"}
+
+	for _, structType := range []Struct{
+		ToStruct(TypeOf[TestMethodEntity]()),
+		ToStruct(TypeOfAny(entity)),
+		ToStruct(ToPointer(TypeOfAny(&entity)).Elem()),
+	} {
+		valueMethods := structType.ValueMethods()
+		pointerMethods := structType.PointerMethods()
+
+		assert.Equal(t, []string{"ChangeOwner", "Describe", "Identifier", "Read"}, methodNames(valueMethods))
+		assert.Equal(t, []string{"ChangeOwner", "Describe", "Identifier", "Read", "Rename", "SetIdentifier"}, methodNames(pointerMethods))
+		assert.Equal(t, methodNames(pointerMethods), methodNames(structType.Methods()))
+		assert.Equal(t, 6, structType.NumMethod())
+	}
+
+	structType := ToStruct(ToPointer(TypeOfAny(&entity)).Elem())
+	describe := structType.ValueMethods()[1]
+
+	results, err := describe.Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{"anna"}, results)
+}
+
+func TestStruct_Implementation(t *testing.T) {
+	structType := ToStruct(TypeOf[TestMethodEntity]())
+	describer := ToInterface(TypeOf[TestDescriber]())
+	renamer := ToInterface(TypeOf[TestRenamer]())
+	stringer := ToInterface(TypeOf[fmt.Stringer]())
+
+	assert.Equal(t, ImplementationBoth, structType.Implementation(describer))
+	assert.True(t, structType.ImplementsByValue(describer))
+	assert.True(t, structType.ImplementsByPointer(describer))
+
+	assert.Equal(t, ImplementationPointer, structType.Implementation(renamer))
+	assert.False(t, structType.ImplementsByValue(renamer))
+	assert.True(t, structType.ImplementsByPointer(renamer))
+
+	assert.Equal(t, ImplementationNone, structType.Implementation(stringer))
+	assert.False(t, structType.ImplementsByValue(stringer))
+	assert.False(t, structType.ImplementsByPointer(stringer))
+
+	assert.Equal(t, ImplementationNone, structType.Implementation(nil))
+}
+
+func TestCustom_ValueAndPointerMethods(t *testing.T) {
+	counter := TestCounter(3)
+	customType := ToCustom(TypeOfAny(counter))
+
+	assert.Equal(t, []string{"Doubled"}, methodNames(customType.ValueMethods()))
+	assert.Equal(t, []string{"Add", "Doubled"}, methodNames(customType.PointerMethods()))
+
+	results, err := customType.ValueMethods()[0].Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{6}, results)
+
+	adder := ToInterface(TypeOf[interface{ Add(int) }]())
+	doubler := ToInterface(TypeOf[interface{ Doubled() int }]())
+
+	assert.Equal(t, ImplementationPointer, customType.Implementation(adder))
+	assert.False(t, customType.ImplementsByValue(adder))
+	assert.True(t, customType.ImplementsByPointer(adder))
+	assert.Equal(t, ImplementationBoth, customType.Implementation(doubler))
+}
+
+func TestImplementationKind_String(t *testing.T) {
+	assert.Equal(t, "none", ImplementationNone.String())
+	assert.Equal(t, "pointer", ImplementationPointer.String())
+	assert.Equal(t, "value-and-pointer", ImplementationBoth.String())
+}
+
+func methodNames(methods []Method) []string {
+	names := make([]string, 0, len(methods))
+
+	for _, method := range methods {
+		names = append(names, method.Name())
+	}
+
+	return names
+}
+
+type TestRepository interface {
+	Find(id int) (string, error)
+	Save(entity string) error
+	Delete(id int) error
+	Count() int
+}
+
+type TestPartialRepository struct{}
+
+func (r TestPartialRepository) Find(id string) (string, error) {
+	return id, nil
+}
+
+func (r *TestPartialRepository) Save(entity string) error {
+	return nil
+}
+
+func (r TestPartialRepository) Count() int {
+	return 0
+}
+
+type TestMemoryRepository struct{}
+
+func (r *TestMemoryRepository) Find(id int) (string, error) {
+	return "", nil
+}
+
+func (r *TestMemoryRepository) Save(entity string) error {
+	return nil
+}
+
+func (r *TestMemoryRepository) Delete(id int) error {
+	return nil
+}
+
+func (r *TestMemoryRepository) Count() int {
+	return 0
+}
+
+func TestCheckImplements(t *testing.T) {
+	repository := ToInterface(TypeOf[TestRepository]())
+	report := CheckImplements(TypeOf[TestPartialRepository](), repository)
+
+	assert.NotNil(t, report)
+	assert.False(t, report.Satisfied())
+	assert.Equal(t, ImplementationNone, report.Kind)
+	assert.Equal(t, []string{"Delete"}, report.Missing)
+	assert.Equal(t, []string{"Save"}, report.PointerReceiver)
+	assert.Len(t, report.Mismatched, 1)
+
+	mismatch := report.Mismatched[0]
+	assert.Equal(t, "Find", mismatch.Name)
+	assert.Equal(t, "int", mismatch.Expected.Parameters()[0].Name())
+	assert.Equal(t, "string", mismatch.Actual.Parameters()[0].Name())
+	assert.Len(t, mismatch.Actual.Results(), 2)
+	assert.Equal(t, "method Find has signature func(string) (string, error) but expected func(int) (string, error)", mismatch.String())
+
+	assert.Equal(t, "reflector.TestPartialRepository does not implement reflector.TestRepository: "+
+		"missing method Delete; "+
+		"method Find has signature func(string) (string, error) but expected func(int) (string, error); "+
+		"method Save has pointer receiver", report.String())
+}
+
+func TestCheckImplements_PointerReceivers(t *testing.T) {
+	repository := ToInterface(TypeOf[TestRepository]())
+
+	report := CheckImplements(TypeOf[TestMemoryRepository](), repository)
+	assert.False(t, report.Satisfied())
+	assert.Equal(t, ImplementationPointer, report.Kind)
+	assert.Empty(t, report.Missing)
+	assert.Empty(t, report.Mismatched)
+	assert.Equal(t, []string{"Count", "Delete", "Find", "Save"}, report.PointerReceiver)
+
+	report = CheckImplements(TypeOf[*TestMemoryRepository](), repository)
+	assert.True(t, report.Satisfied())
+	assert.Equal(t, ImplementationBoth, report.Kind)
+	assert.Equal(t, "*reflector.TestMemoryRepository implements reflector.TestRepository", report.String())
+
+	report = CheckImplements(TypeOf[*TestPartialRepository](), repository)
+	assert.False(t, report.Satisfied())
+	assert.Equal(t, []string{"Delete"}, report.Missing)
+	assert.Empty(t, report.PointerReceiver)
+}
+
+func TestCheckImplements_Interfaces(t *testing.T) {
+	report := CheckImplements(TypeOf[fmt.Stringer](), ToInterface(TypeOf[TestDescriber]()))
+	assert.Equal(t, []string{"Describe"}, report.Missing)
+
+	report = CheckImplements(TypeOf[TestRepository](), ToInterface(TypeOf[interface{ Count() int }]()))
+	assert.True(t, report.Satisfied())
+
+	report = CheckImplements(TypeOf[TestCounter](), ToInterface(TypeOf[interface{ Add(int) }]()))
+	assert.Equal(t, []string{"Add"}, report.PointerReceiver)
+
+	assert.Nil(t, CheckImplements(nil, ToInterface(TypeOf[TestDescriber]())))
+	assert.Nil(t, CheckImplements(TypeOf[TestCounter](), nil))
+}
+
+type TestSealed interface {
+	Name() string
+	sealed()
+}
+
+type TestSealedValue struct{}
+
+func (v TestSealedValue) Name() string {
+	return "value"
+}
+
+func (v TestSealedValue) sealed() {}
+
+type TestSealedPointer struct{}
+
+func (p TestSealedPointer) Name() string {
+	return "pointer"
+}
+
+func (p *TestSealedPointer) sealed() {}
+
+type TestSealedMissing struct{}
+
+func (m TestSealedMissing) Name() string {
+	return "missing"
+}
+
+func TestCheckImplements_UnexportedMethods(t *testing.T) {
+	sealed := ToInterface(TypeOf[TestSealed]())
+
+	report := CheckImplements(TypeOf[TestSealedValue](), sealed)
+	assert.True(t, report.Satisfied())
+	assert.Equal(t, ImplementationBoth, report.Kind)
+	assert.Empty(t, report.Missing)
+
+	report = CheckImplements(TypeOf[TestSealedPointer](), sealed)
+	assert.False(t, report.Satisfied())
+	assert.Equal(t, ImplementationPointer, report.Kind)
+	assert.Empty(t, report.Missing)
+	assert.Equal(t, []string{"sealed"}, report.PointerReceiver)
+
+	report = CheckImplements(TypeOf[TestSealedMissing](), sealed)
+	assert.False(t, report.Satisfied())
+	assert.Equal(t, ImplementationNone, report.Kind)
+	assert.Equal(t, []string{"sealed"}, report.Missing)
+
+	report = CheckImplements(TypeOf[TestSealed](), sealed)
+	assert.True(t, report.Satisfied())
+}
+
+type TestImplementsHolder struct {
+	Audit   TestMethodAudit
+	Counter TestCounter
+}
+
+func (h TestImplementsHolder) Describe() string {
+	return "holder"
+}
+
+func TestImplements_FieldTypes(t *testing.T) {
+	describer := ToInterface(TypeOf[TestDescriber]())
+	adder := ToInterface(TypeOf[interface{ Add(int) }]())
+	doubler := ToInterface(TypeOf[interface{ Doubled() int }]())
+
+	holder := ToStruct(TypeOf[TestImplementsHolder]())
+	assert.True(t, holder.Implements(describer))
+
+	audit, exists := holder.FieldByName("Audit")
+	assert.True(t, exists)
+	assert.False(t, ToStruct(audit.Type()).Implements(describer))
+
+	counter, exists := holder.FieldByName("Counter")
+	assert.True(t, exists)
+	assert.True(t, ToCustom(counter.Type()).Implements(doubler))
+	assert.False(t, ToCustom(counter.Type()).Implements(adder))
+	assert.False(t, ToCustom(counter.Type()).Implements(nil))
+
+	pointer := ToPointer(TypeOf[*TestCounter]())
+	assert.True(t, ToCustom(pointer.Elem()).Implements(adder))
+}
@@ -84,6 +84,14 @@ func (i *interfaceType) ReflectValue() *reflect.Value {
 	return i.reflectValue
 }
 
+func (i *interfaceType) Kind() Kind {
+	return KindInterface
+}
+
+func (i *interfaceType) UnderlyingKind() Kind {
+	return KindInterface
+}
+
 func (i *interfaceType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -138,11 +146,8 @@ func (i *interfaceType) Underlying() Type {
 
 func (i *interfaceType) Methods() []Method {
 	functions := make([]Method, 0)
-	numMethod := i.reflectType.NumMethod()
-
-	for index := 0; index < numMethod; index++ {
-		function := i.reflectType.Method(index)
 
+	for _, function := range metadataOf(i.reflectType).methodSet() {
 		method := &methodType{
 			parent:        i,
 			reflectMethod: function,
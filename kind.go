@@ -0,0 +1,206 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Kind int
+
+const (
+	KindInvalid Kind = iota
+	KindBoolean
+	KindString
+	KindSignedInteger
+	KindUnsignedInteger
+	KindFloat
+	KindComplex
+	KindUintptr
+	KindUnsafePointer
+	KindPointer
+	KindStruct
+	KindInterface
+	KindFunction
+	KindMethod
+	KindMap
+	KindArray
+	KindSlice
+	KindChan
+	KindCustom
+)
+
+var kindNames = map[Kind]string{
+	KindInvalid:         "invalid",
+	KindBoolean:         "boolean",
+	KindString:          "string",
+	KindSignedInteger:   "signed-integer",
+	KindUnsignedInteger: "unsigned-integer",
+	KindFloat:           "float",
+	KindComplex:         "complex",
+	KindUintptr:         "uintptr",
+	KindUnsafePointer:   "unsafe-pointer",
+	KindPointer:         "pointer",
+	KindStruct:          "struct",
+	KindInterface:       "interface",
+	KindFunction:        "function",
+	KindMethod:          "method",
+	KindMap:             "map",
+	KindArray:           "array",
+	KindSlice:           "slice",
+	KindChan:            "chan",
+	KindCustom:          "custom",
+}
+
+func (k Kind) String() string {
+	if name, exists := kindNames[k]; exists {
+		return name
+	}
+
+	return kindNames[KindInvalid]
+}
+
+type KindVisitor interface {
+	VisitBoolean(typ Boolean) error
+	VisitString(typ String) error
+	VisitSignedInteger(typ SignedInteger) error
+	VisitUnsignedInteger(typ UnsignedInteger) error
+	VisitFloat(typ Float) error
+	VisitComplex(typ Complex) error
+	VisitUintptr(typ Uintptr) error
+	VisitUnsafePointer(typ UnsafePointer) error
+	VisitPointer(typ Pointer) error
+	VisitStruct(typ Struct) error
+	VisitInterface(typ Interface) error
+	VisitFunction(typ Function) error
+	VisitMethod(typ Method) error
+	VisitMap(typ Map) error
+	VisitArray(typ Array) error
+	VisitSlice(typ Slice) error
+	VisitChan(typ Chan) error
+	VisitCustom(typ Custom) error
+}
+
+type BaseKindVisitor struct{}
+
+func (v BaseKindVisitor) VisitBoolean(typ Boolean) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitString(typ String) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitSignedInteger(typ SignedInteger) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitUnsignedInteger(typ UnsignedInteger) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitFloat(typ Float) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitComplex(typ Complex) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitUintptr(typ Uintptr) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitUnsafePointer(typ UnsafePointer) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitPointer(typ Pointer) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitStruct(typ Struct) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitInterface(typ Interface) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitFunction(typ Function) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitMethod(typ Method) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitMap(typ Map) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitArray(typ Array) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitSlice(typ Slice) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitChan(typ Chan) error {
+	return nil
+}
+
+func (v BaseKindVisitor) VisitCustom(typ Custom) error {
+	return nil
+}
+
+func Visit(typ Type, visitor KindVisitor) error {
+	if typ == nil {
+		return errors.New("typ should not be nil")
+	}
+
+	if visitor == nil {
+		return errors.New("visitor should not be nil")
+	}
+
+	switch typ.Kind() {
+	case KindBoolean:
+		return visitor.VisitBoolean(typ.(Boolean))
+	case KindString:
+		return visitor.VisitString(typ.(String))
+	case KindSignedInteger:
+		return visitor.VisitSignedInteger(typ.(SignedInteger))
+	case KindUnsignedInteger:
+		return visitor.VisitUnsignedInteger(typ.(UnsignedInteger))
+	case KindFloat:
+		return visitor.VisitFloat(typ.(Float))
+	case KindComplex:
+		return visitor.VisitComplex(typ.(Complex))
+	case KindUintptr:
+		return visitor.VisitUintptr(typ.(Uintptr))
+	case KindUnsafePointer:
+		return visitor.VisitUnsafePointer(typ.(UnsafePointer))
+	case KindPointer:
+		return visitor.VisitPointer(typ.(Pointer))
+	case KindStruct:
+		return visitor.VisitStruct(typ.(Struct))
+	case KindInterface:
+		return visitor.VisitInterface(typ.(Interface))
+	case KindFunction:
+		return visitor.VisitFunction(typ.(Function))
+	case KindMethod:
+		return visitor.VisitMethod(typ.(Method))
+	case KindMap:
+		return visitor.VisitMap(typ.(Map))
+	case KindArray:
+		return visitor.VisitArray(typ.(Array))
+	case KindSlice:
+		return visitor.VisitSlice(typ.(Slice))
+	case KindChan:
+		return visitor.VisitChan(typ.(Chan))
+	case KindCustom:
+		return visitor.VisitCustom(typ.(Custom))
+	default:
+		return fmt.Errorf("kind '%s' cannot be visited", typ.Kind())
+	}
+}
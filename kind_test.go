@@ -0,0 +1,95 @@
+package reflector
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"unsafe"
+)
+
+type TestKindStatus string
+
+type TestKindVisitor struct {
+	BaseKindVisitor
+	visited []string
+}
+
+func (v *TestKindVisitor) VisitStruct(typ Struct) error {
+	v.visited = append(v.visited, "struct:"+typ.Name())
+	return nil
+}
+
+func (v *TestKindVisitor) VisitSlice(typ Slice) error {
+	v.visited = append(v.visited, "slice:"+typ.Name())
+	return nil
+}
+
+func (v *TestKindVisitor) VisitCustom(typ Custom) error {
+	v.visited = append(v.visited, "custom:"+typ.Name())
+	return nil
+}
+
+func (v *TestKindVisitor) VisitChan(typ Chan) error {
+	return errors.New("chan is not supported")
+}
+
+func TestType_Kind(t *testing.T) {
+	testCases := []struct {
+		typ            Type
+		kind           Kind
+		underlyingKind Kind
+	}{
+		{TypeOf[bool](), KindBoolean, KindBoolean},
+		{TypeOf[string](), KindString, KindString},
+		{TypeOf[int32](), KindSignedInteger, KindSignedInteger},
+		{TypeOf[uint8](), KindUnsignedInteger, KindUnsignedInteger},
+		{TypeOf[float64](), KindFloat, KindFloat},
+		{TypeOf[complex64](), KindComplex, KindComplex},
+		{TypeOf[uintptr](), KindUintptr, KindUintptr},
+		{TypeOf[unsafe.Pointer](), KindUnsafePointer, KindUnsafePointer},
+		{TypeOf[*int](), KindPointer, KindPointer},
+		{TypeOf[TestKindVisitor](), KindStruct, KindStruct},
+		{TypeOf[error](), KindInterface, KindInterface},
+		{TypeOf[func()](), KindFunction, KindFunction},
+		{TypeOf[map[string]int](), KindMap, KindMap},
+		{TypeOf[[2]int](), KindArray, KindArray},
+		{TypeOf[[]int](), KindSlice, KindSlice},
+		{TypeOf[chan int](), KindChan, KindChan},
+		{TypeOf[TestKindStatus](), KindCustom, KindString},
+		{TypeOf[TestTree](), KindCustom, KindMap},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.kind, testCase.typ.Kind(), testCase.typ.Name())
+		assert.Equal(t, testCase.underlyingKind, testCase.typ.UnderlyingKind(), testCase.typ.Name())
+	}
+
+	method, exists := ToStruct(TypeOf[TestMetadataStruct]()).MethodByName("Greet")
+	assert.True(t, exists)
+	assert.Equal(t, KindMethod, method.Kind())
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "struct", KindStruct.String())
+	assert.Equal(t, "unsigned-integer", KindUnsignedInteger.String())
+	assert.Equal(t, "custom", KindCustom.String())
+	assert.Equal(t, "invalid", Kind(-1).String())
+}
+
+func TestVisit(t *testing.T) {
+	visitor := &TestKindVisitor{}
+
+	assert.Nil(t, Visit(TypeOf[TestKindVisitor](), visitor))
+	assert.Nil(t, Visit(TypeOf[[]string](), visitor))
+	assert.Nil(t, Visit(TypeOf[TestKindStatus](), visitor))
+	assert.Nil(t, Visit(TypeOf[int](), visitor))
+
+	assert.Equal(t, []string{"struct:TestKindVisitor", "slice:[]string", "custom:TestKindStatus"}, visitor.visited)
+
+	err := Visit(TypeOf[chan int](), visitor)
+	assert.NotNil(t, err)
+	assert.Equal(t, "chan is not supported", err.Error())
+
+	assert.Equal(t, "typ should not be nil", Visit(nil, visitor).Error())
+	assert.Equal(t, "visitor should not be nil", Visit(TypeOf[int](), nil).Error())
+}
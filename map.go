@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type Entry interface {
@@ -41,8 +42,10 @@ type Map interface {
 }
 
 type mapType struct {
-	key  Type
-	elem Type
+	keyOnce  sync.Once
+	key      Type
+	elemOnce sync.Once
+	elem     Type
 
 	parent       Type
 	reflectType  reflect.Type
@@ -52,9 +55,9 @@ type mapType struct {
 func (m *mapType) Name() string {
 	var builder strings.Builder
 	builder.WriteString("map[")
-	builder.WriteString(m.key.Name())
+	builder.WriteString(m.keyType().Name())
 	builder.WriteString("]")
-	builder.WriteString(m.elem.Name())
+	builder.WriteString(m.elemType().Name())
 	return builder.String()
 }
 
@@ -107,6 +110,14 @@ func (m *mapType) ReflectValue() *reflect.Value {
 	return m.reflectValue
 }
 
+func (m *mapType) Kind() Kind {
+	return KindMap
+}
+
+func (m *mapType) UnderlyingKind() Kind {
+	return KindMap
+}
+
 func (m *mapType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -121,7 +132,7 @@ func (m *mapType) IsInstantiable() bool {
 
 func (m *mapType) Instantiate() (Value, error) {
 	ptr := reflect.New(m.reflectType).Interface()
-	emptyMap := reflect.MakeMapWithSize(reflect.MapOf(m.key.ReflectType(), m.elem.ReflectType()), 0)
+	emptyMap := reflect.MakeMapWithSize(reflect.MapOf(m.keyType().ReflectType(), m.elemType().ReflectType()), 0)
 	reflect.ValueOf(ptr).Elem().Set(emptyMap)
 	return &value{
 		reflect.ValueOf(ptr),
@@ -161,11 +172,11 @@ func (m *mapType) Convert(typ Type) (Value, error) {
 }
 
 func (m *mapType) Key() Type {
-	return m.key
+	return m.keyType()
 }
 
 func (m *mapType) Elem() Type {
-	return m.elem
+	return m.elemType()
 }
 
 func (m *mapType) Len() (int, error) {
@@ -252,7 +263,7 @@ func (m *mapType) Put(key any, val any) error {
 		return errors.New("value reference is nil")
 	}
 
-	m.reflectValue.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+	m.reflectValue.SetMapIndex(reflect.ValueOf(key), valueOrZero(val, m.reflectType.Elem()))
 	return nil
 }
 
@@ -277,3 +288,19 @@ func (m *mapType) Clear() error {
 	}
 	return nil
 }
+
+func (m *mapType) keyType() Type {
+	m.keyOnce.Do(func() {
+		m.key = typeOf(nil, m.reflectType.Key(), m.reflectValue, m)
+	})
+
+	return m.key
+}
+
+func (m *mapType) elemType() Type {
+	m.elemOnce.Do(func() {
+		m.elem = typeOf(nil, m.reflectType.Elem(), m.reflectValue, m)
+	})
+
+	return m.elem
+}
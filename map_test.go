@@ -571,3 +571,30 @@ func TestTypeOfMapObjectPointer(t *testing.T) {
 	assert.True(t, ok)
 	assert.Empty(t, mapVal)
 }
+
+type TestTree map[string]TestTree
+
+func TestTypeOfMap_RecursiveType(t *testing.T) {
+	tree := TestTree{"a": TestTree{"b": nil}}
+	typ := TypeOfAny(tree)
+
+	assert.Equal(t, "TestTree", typ.Name())
+	assert.True(t, IsCustom(typ))
+
+	mapType := ToMap(ToCustom(typ).Underlying())
+	assert.NotNil(t, mapType)
+	assert.Equal(t, "map[string]TestTree", mapType.Name())
+	assert.Equal(t, "string", mapType.Key().Name())
+	assert.Equal(t, "TestTree", mapType.Elem().Name())
+	assert.Equal(t, mapType, mapType.Elem().Parent())
+
+	elem := ToMap(ToCustom(mapType.Elem()).Underlying())
+	assert.Equal(t, "TestTree", elem.Elem().Name())
+
+	length, err := mapType.Len()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, length)
+
+	assert.Equal(t, "TestTree", TypeOf[TestTree]().Name())
+	assert.Equal(t, "map[string]TestTree", TypeOf[map[string]TestTree]().Name())
+}
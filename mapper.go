@@ -0,0 +1,40 @@
+package reflector
+
+import (
+	"errors"
+	"reflect"
+)
+
+type Mapper[Src, Dst any] interface {
+	Map(dst *Dst, src *Src) error
+}
+
+type mapper[Src, Dst any] struct {
+	convert converter
+}
+
+func CompileMapper[Src, Dst any](opts ...MappingOption) (Mapper[Src, Dst], error) {
+	srcType := reflect.TypeOf((*Src)(nil)).Elem()
+	dstType := reflect.TypeOf((*Dst)(nil)).Elem()
+
+	convert, err := newPlanner(opts).compile(dstType, srcType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mapper[Src, Dst]{
+		convert: convert,
+	}, nil
+}
+
+func (m *mapper[Src, Dst]) Map(dst *Dst, src *Src) error {
+	if dst == nil {
+		return errors.New("dst should not be nil")
+	}
+
+	if src == nil {
+		return errors.New("src should not be nil")
+	}
+
+	return m.convert(reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem())
+}
@@ -0,0 +1,206 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type TestMapperNode struct {
+	Name     string
+	Children []*TestMapperNode
+}
+
+type TestMapperNodeDTO struct {
+	Name     string
+	Children []TestMapperNodeDTO
+}
+
+func TestCompileMapper(t *testing.T) {
+	unmapped := make([]string, 0)
+	mapper, err := CompileMapper[TestMappingUserDTO, TestMappingUser](UnmappedFields(&unmapped))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Phone"}, unmapped)
+
+	email := "anna@test.com"
+	dto := TestMappingUserDTO{
+		ID:       42,
+		FullName: "Anna",
+		Email:    &email,
+		Address:  &TestMappingAddressDTO{Street: "Main", Zip: 10115},
+	}
+
+	user := TestMappingUser{}
+	err = mapper.Map(&user, &dto)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), user.ID)
+	assert.Equal(t, "Anna", user.Name)
+	assert.Equal(t, "anna@test.com", user.Email)
+	assert.Equal(t, TestMappingAddress{Street: "Main", Zip: 10115}, user.Address)
+
+	assert.Equal(t, "dst should not be nil", mapper.Map(nil, &dto).Error())
+	assert.Equal(t, "src should not be nil", mapper.Map(&user, nil).Error())
+}
+
+func TestCompileMapper_RecursiveTypes(t *testing.T) {
+	mapper, err := CompileMapper[TestMapperNode, TestMapperNodeDTO]()
+	assert.Nil(t, err)
+
+	root := TestMapperNode{
+		Name: "root",
+		Children: []*TestMapperNode{
+			{Name: "child", Children: []*TestMapperNode{{Name: "leaf"}}},
+		},
+	}
+
+	dto := TestMapperNodeDTO{}
+	err = mapper.Map(&dto, &root)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TestMapperNodeDTO{
+		Name: "root",
+		Children: []TestMapperNodeDTO{
+			{Name: "child", Children: []TestMapperNodeDTO{{Name: "leaf"}}},
+		},
+	}, dto)
+}
+
+func TestCompileMapper_Errors(t *testing.T) {
+	_, err := CompileMapper[TestMappingUserDTO, TestMappingUser](ErrorOnUnmapped())
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping: unmapped fields: Phone", err.Error())
+
+	_, err = CompileMapper[struct{ Address string }, TestMappingUser]()
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping 'Address': cannot convert string to reflector.TestMappingAddress", err.Error())
+}
+
+func TestCompileMapper_IsSafeForConcurrentUse(t *testing.T) {
+	mapper, err := CompileMapper[TestMappingAddressDTO, TestMappingAddress]()
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	results := make([]TestMappingAddress, 32)
+
+	for i := range results {
+		wg.Add(1)
+
+		go func(index int) {
+			defer wg.Done()
+
+			src := TestMappingAddressDTO{Street: "Main", Zip: int32(index)}
+			assert.Nil(t, mapper.Map(&results[index], &src))
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, result := range results {
+		assert.Equal(t, TestMappingAddress{Street: "Main", Zip: int64(i)}, result)
+	}
+}
+
+func benchmarkMapperSource() TestMappingUserDTO {
+	email := "anna@test.com"
+	return TestMappingUserDTO{
+		ID:       42,
+		FullName: "Anna",
+		Email:    &email,
+		Status:   "active",
+		Address:  &TestMappingAddressDTO{Street: "Main", Zip: 10115},
+		Previous: []TestMappingAddressDTO{{Street: "Old", Zip: 1}},
+		Scores:   map[string]int32{"math": 90},
+		ADMIN:    true,
+	}
+}
+
+func BenchmarkCompiledMapper(b *testing.B) {
+	mapper, err := CompileMapper[TestMappingUserDTO, TestMappingUser]()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	src := benchmarkMapperSource()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dst := TestMappingUser{}
+		if err = mapper.Map(&dst, &src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCopyStruct(b *testing.B) {
+	src := benchmarkMapperSource()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dst := TestMappingUser{}
+		if err := CopyStruct(&dst, &src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFieldsLoopFlat(b *testing.B) {
+	src := TestMappingAddressDTO{Street: "Main", Zip: 10115}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dst := TestMappingAddress{}
+		srcStruct := ToStruct(ToPointer(TypeOfAny(&src)).Elem())
+		dstStruct := ToStruct(ToPointer(TypeOfAny(&dst)).Elem())
+
+		for _, srcField := range srcStruct.Fields() {
+			dstField, exists := dstStruct.FieldByName(srcField.Name())
+			if !exists {
+				continue
+			}
+
+			val, _ := srcField.Value()
+			converted, _ := convertValue(val, dstField.ReflectStructField().Type)
+			_ = dstField.SetValue(converted.Interface())
+		}
+	}
+}
+
+func BenchmarkCompiledMapperFlat(b *testing.B) {
+	mapper, err := CompileMapper[TestMappingAddressDTO, TestMappingAddress]()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	src := TestMappingAddressDTO{Street: "Main", Zip: 10115}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dst := TestMappingAddress{}
+		if err = mapper.Map(&dst, &src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestCompileMapper_UnexportedEmbeddedPointer(t *testing.T) {
+	mapper, err := CompileMapper[struct{ X, Y int }, TestMappingEmbeddedPointerDst]()
+	assert.Nil(t, err)
+
+	src := struct{ X, Y int }{X: 1, Y: 2}
+	dst := TestMappingEmbeddedPointerDst{}
+
+	err = mapper.Map(&dst, &src)
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping 'X': embedded pointer 'testMappingInner' cannot be allocated", err.Error())
+
+	dst = TestMappingEmbeddedPointerDst{testMappingInner: &testMappingInner{}}
+	assert.Nil(t, mapper.Map(&dst, &src))
+	assert.Equal(t, 1, dst.X)
+	assert.Equal(t, 2, dst.Y)
+}
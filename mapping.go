@@ -0,0 +1,487 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type MappingOption func(options *mappingOptions)
+
+type mappingOptions struct {
+	tagKey          string
+	unmappedFields  *[]string
+	errorOnUnmapped bool
+}
+
+func MappingTagKey(key string) MappingOption {
+	return func(options *mappingOptions) {
+		options.tagKey = key
+	}
+}
+
+func UnmappedFields(fields *[]string) MappingOption {
+	return func(options *mappingOptions) {
+		options.unmappedFields = fields
+	}
+}
+
+func ErrorOnUnmapped() MappingOption {
+	return func(options *mappingOptions) {
+		options.errorOnUnmapped = true
+	}
+}
+
+type MappingError struct {
+	Path   string
+	Reason string
+}
+
+func (e *MappingError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("mapping: %s", e.Reason)
+	}
+
+	return fmt.Sprintf("mapping '%s': %s", e.Path, e.Reason)
+}
+
+type converter func(dst reflect.Value, src reflect.Value) error
+
+type typePair struct {
+	dst reflect.Type
+	src reflect.Type
+}
+
+type fieldPlan struct {
+	name      string
+	dstIndex  []int
+	srcIndex  []int
+	converter converter
+}
+
+type structPlan struct {
+	fields []fieldPlan
+}
+
+type planner struct {
+	options  mappingOptions
+	plans    map[typePair]*structPlan
+	unmapped []string
+}
+
+func newPlanner(opts []MappingOption) *planner {
+	p := &planner{
+		options: mappingOptions{
+			tagKey: "map",
+		},
+		plans:    make(map[typePair]*structPlan),
+		unmapped: make([]string, 0),
+	}
+
+	for _, opt := range opts {
+		opt(&p.options)
+	}
+
+	return p
+}
+
+func CopyStruct(dst, src any, opts ...MappingOption) error {
+	dstVal := reflect.ValueOf(dst)
+
+	if dstVal.Kind() != reflect.Ptr || dstVal.IsNil() || dstVal.Elem().Kind() != reflect.Struct {
+		return errors.New("dst should be a non-nil pointer to a struct")
+	}
+
+	srcVal := reflect.ValueOf(src)
+
+	for srcVal.Kind() == reflect.Ptr && !srcVal.IsNil() {
+		srcVal = srcVal.Elem()
+	}
+
+	if srcVal.Kind() != reflect.Struct {
+		return errors.New("src should be a struct or a non-nil pointer to a struct")
+	}
+
+	p := newPlanner(opts)
+
+	convert, err := p.compile(dstVal.Elem().Type(), srcVal.Type())
+	if err != nil {
+		return err
+	}
+
+	return convert(dstVal.Elem(), srcVal)
+}
+
+func (p *planner) compile(dstType, srcType reflect.Type) (converter, error) {
+	convert, err := p.converterOf("", dstType, srcType)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.options.unmappedFields != nil {
+		*p.options.unmappedFields = p.unmapped
+	}
+
+	if p.options.errorOnUnmapped && len(p.unmapped) != 0 {
+		return nil, &MappingError{Reason: fmt.Sprintf("unmapped fields: %s", strings.Join(p.unmapped, ", "))}
+	}
+
+	return convert, nil
+}
+
+func (p *planner) converterOf(path string, dstType, srcType reflect.Type) (converter, error) {
+	if dstType == srcType {
+		return func(dst reflect.Value, src reflect.Value) error {
+			dst.Set(src)
+			return nil
+		}, nil
+	}
+
+	switch {
+	case srcType.Kind() == reflect.Interface:
+		return func(dst reflect.Value, src reflect.Value) error {
+			converted, err := convertReflectValue(src, dstType)
+			if err != nil {
+				return &MappingError{Reason: err.Error()}
+			}
+
+			dst.Set(converted)
+			return nil
+		}, nil
+	case dstType.Kind() == reflect.Interface:
+		if !srcType.Implements(dstType) {
+			break
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			dst.Set(src)
+			return nil
+		}, nil
+	case dstType.Kind() == reflect.Ptr && srcType.Kind() == reflect.Ptr:
+		convert, err := p.converterOf(path, dstType.Elem(), srcType.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			if src.IsNil() {
+				dst.Set(reflect.Zero(dstType))
+				return nil
+			}
+
+			elem := reflect.New(dstType.Elem())
+			if err := convert(elem.Elem(), src.Elem()); err != nil {
+				return err
+			}
+
+			dst.Set(elem)
+			return nil
+		}, nil
+	case srcType.Kind() == reflect.Ptr:
+		convert, err := p.converterOf(path, dstType, srcType.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			if src.IsNil() {
+				dst.Set(reflect.Zero(dstType))
+				return nil
+			}
+
+			return convert(dst, src.Elem())
+		}, nil
+	case dstType.Kind() == reflect.Ptr:
+		convert, err := p.converterOf(path, dstType.Elem(), srcType)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			elem := reflect.New(dstType.Elem())
+			if err := convert(elem.Elem(), src); err != nil {
+				return err
+			}
+
+			dst.Set(elem)
+			return nil
+		}, nil
+	case dstType.Kind() == reflect.Struct && srcType.Kind() == reflect.Struct:
+		plan, err := p.structPlanOf(path, dstType, srcType)
+		if err != nil {
+			return nil, err
+		}
+
+		return plan.apply, nil
+	case dstType.Kind() == reflect.Slice && (srcType.Kind() == reflect.Slice || srcType.Kind() == reflect.Array):
+		convert, err := p.converterOf(path, dstType.Elem(), srcType.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			if src.Kind() == reflect.Slice && src.IsNil() {
+				dst.Set(reflect.Zero(dstType))
+				return nil
+			}
+
+			length := src.Len()
+			elems := reflect.MakeSlice(dstType, length, length)
+
+			for i := 0; i < length; i++ {
+				if err := convert(elems.Index(i), src.Index(i)); err != nil {
+					return prefixPath(err, "["+strconv.Itoa(i)+"]")
+				}
+			}
+
+			dst.Set(elems)
+			return nil
+		}, nil
+	case dstType.Kind() == reflect.Array && (srcType.Kind() == reflect.Slice || srcType.Kind() == reflect.Array):
+		convert, err := p.converterOf(path, dstType.Elem(), srcType.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			length := src.Len()
+
+			if length > dstType.Len() {
+				return &MappingError{Reason: fmt.Sprintf("%d elements exceed %s", length, dstType.String())}
+			}
+
+			elems := reflect.New(dstType).Elem()
+
+			for i := 0; i < length; i++ {
+				if err := convert(elems.Index(i), src.Index(i)); err != nil {
+					return prefixPath(err, "["+strconv.Itoa(i)+"]")
+				}
+			}
+
+			dst.Set(elems)
+			return nil
+		}, nil
+	case dstType.Kind() == reflect.Map && srcType.Kind() == reflect.Map:
+		convertKey, err := p.converterOf(path, dstType.Key(), srcType.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		convertElem, err := p.converterOf(path, dstType.Elem(), srcType.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			if src.IsNil() {
+				dst.Set(reflect.Zero(dstType))
+				return nil
+			}
+
+			entries := reflect.MakeMapWithSize(dstType, src.Len())
+			iterator := src.MapRange()
+
+			for iterator.Next() {
+				key := reflect.New(dstType.Key()).Elem()
+				if err := convertKey(key, iterator.Key()); err != nil {
+					return prefixPath(err, "["+formatKey(iterator.Key())+"]")
+				}
+
+				elem := reflect.New(dstType.Elem()).Elem()
+				if err := convertElem(elem, iterator.Value()); err != nil {
+					return prefixPath(err, "["+formatKey(iterator.Key())+"]")
+				}
+
+				entries.SetMapIndex(key, elem)
+			}
+
+			dst.Set(entries)
+			return nil
+		}, nil
+	case isNumberKind(dstType.Kind()) && isNumberKind(srcType.Kind()), dstType.Kind() == srcType.Kind():
+		source := typeOf(reflect.PtrTo(srcType), srcType, nil, nil)
+		target := typeOf(reflect.PtrTo(dstType), dstType, nil, nil)
+
+		if source == nil || target == nil || !source.CanConvert(target) {
+			break
+		}
+
+		return func(dst reflect.Value, src reflect.Value) error {
+			converted, err := convertReflectValue(src, dstType)
+			if err != nil {
+				return &MappingError{Reason: err.Error()}
+			}
+
+			dst.Set(converted)
+			return nil
+		}, nil
+	}
+
+	return nil, &MappingError{Reason: fmt.Sprintf("cannot convert %s to %s", srcType.String(), dstType.String())}
+}
+
+func (p *planner) structPlanOf(path string, dstType, srcType reflect.Type) (*structPlan, error) {
+	key := typePair{dstType, srcType}
+
+	if plan, exists := p.plans[key]; exists {
+		return plan, nil
+	}
+
+	plan := &structPlan{
+		fields: make([]fieldPlan, 0),
+	}
+	p.plans[key] = plan
+
+	dstStruct := ToStruct(typeOf(reflect.PtrTo(dstType), dstType, nil, nil))
+	srcStruct := ToStruct(typeOf(reflect.PtrTo(srcType), srcType, nil, nil))
+
+	if err := p.planFields(plan, path, []int{}, dstStruct, srcStruct); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (p *planner) planFields(plan *structPlan, path string, dstIndex []int, dstStruct, srcStruct Struct) error {
+	for _, dstField := range dstStruct.Fields() {
+		name := dstField.Name()
+
+		if tag, exists := dstField.Tags().Find(p.options.tagKey); exists {
+			if tag.Value() == "-" {
+				continue
+			}
+
+			if tag.Primary() != "" {
+				name = tag.Primary()
+			}
+		}
+
+		fieldPath := joinPath(path, dstField.Name())
+		fieldIndex := append(append([]int{}, dstIndex...), dstField.Index())
+		srcField, matched := p.sourceFieldOf(srcStruct, name)
+
+		if dstField.IsAnonymous() && !matched {
+			embedded := dstField.ReflectStructField().Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				embeddedStruct := ToStruct(typeOf(reflect.PtrTo(embedded), embedded, nil, nil))
+
+				if err := p.planFields(plan, path, fieldIndex, embeddedStruct, srcStruct); err != nil {
+					return err
+				}
+
+				continue
+			}
+		}
+
+		if !dstField.IsExported() {
+			continue
+		}
+
+		if !matched {
+			p.unmapped = append(p.unmapped, fieldPath)
+			continue
+		}
+
+		convert, err := p.converterOf(fieldPath, dstField.ReflectStructField().Type, srcField.ReflectStructField().Type)
+		if err != nil {
+			return prefixPath(err, dstField.Name())
+		}
+
+		plan.fields = append(plan.fields, fieldPlan{
+			name:      dstField.Name(),
+			dstIndex:  fieldIndex,
+			srcIndex:  srcField.IndexPath(),
+			converter: convert,
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) sourceFieldOf(srcStruct Struct, name string) (Field, bool) {
+	fields := srcStruct.VisibleFieldsByTag(p.options.tagKey)
+
+	for _, field := range fields {
+		if field.VisibleName() == name {
+			return field, true
+		}
+	}
+
+	if field, exists := srcStruct.FieldByName(name); exists && field.IsExported() {
+		return field, true
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.VisibleName(), name) || strings.EqualFold(field.Name(), name) {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *structPlan) apply(dst reflect.Value, src reflect.Value) error {
+	for _, field := range s.fields {
+		srcVal, _ := valueByIndex(src, field.srcIndex, false)
+		if !srcVal.IsValid() {
+			continue
+		}
+
+		dstVal, err := valueByIndex(dst, field.dstIndex, true)
+		if err != nil {
+			return prefixPath(err, field.name)
+		}
+
+		if err = field.converter(dstVal, srcVal); err != nil {
+			return prefixPath(err, field.name)
+		}
+	}
+
+	return nil
+}
+
+func valueByIndex(val reflect.Value, index []int, allocate bool) (reflect.Value, error) {
+	for i, fieldIndex := range index {
+		if i > 0 && val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				if !allocate {
+					return reflect.Value{}, nil
+				}
+
+				if !val.CanSet() {
+					return reflect.Value{}, fmt.Errorf("embedded pointer '%s' cannot be allocated", val.Type().Elem().Name())
+				}
+
+				val.Set(reflect.New(val.Type().Elem()))
+			}
+
+			val = val.Elem()
+		}
+
+		val = val.Field(fieldIndex)
+	}
+
+	return val, nil
+}
+
+func prefixPath(err error, prefix string) error {
+	mappingErr, ok := err.(*MappingError)
+	if !ok {
+		return &MappingError{Path: prefix, Reason: err.Error()}
+	}
+
+	path := prefix
+	if strings.HasPrefix(mappingErr.Path, "[") {
+		path += mappingErr.Path
+	} else if mappingErr.Path != "" {
+		path = joinPath(prefix, mappingErr.Path)
+	}
+
+	return &MappingError{Path: path, Reason: mappingErr.Reason}
+}
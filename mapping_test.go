@@ -0,0 +1,172 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestMappingStatus string
+
+type TestMappingAuditDTO struct {
+	CreatedBy string
+}
+
+type TestMappingAddressDTO struct {
+	Street string
+	Zip    int32
+}
+
+type TestMappingUserDTO struct {
+	TestMappingAuditDTO
+	ID       int32
+	FullName string
+	Email    *string
+	Status   string
+	Address  *TestMappingAddressDTO
+	Previous []TestMappingAddressDTO
+	Scores   map[string]int32
+	Tags     [2]string
+	ADMIN    bool
+	Secret   string `map:"-"`
+}
+
+type TestMappingAddress struct {
+	Street string
+	Zip    int64
+}
+
+type TestMappingAudit struct {
+	CreatedBy string
+}
+
+type TestMappingUser struct {
+	*TestMappingAudit
+	ID       int64
+	Name     string `map:"FullName"`
+	Email    string
+	Status   TestMappingStatus
+	Address  TestMappingAddress
+	Previous []*TestMappingAddress
+	Scores   map[string]float64
+	Tags     []string
+	Admin    bool
+	Secret   string
+	Phone    string
+	internal string
+}
+
+func TestCopyStruct(t *testing.T) {
+	email := "anna@test.com"
+	dto := &TestMappingUserDTO{
+		TestMappingAuditDTO: TestMappingAuditDTO{CreatedBy: "system"},
+		ID:                  42,
+		FullName:            "Anna",
+		Email:               &email,
+		Status:              "active",
+		Address:             &TestMappingAddressDTO{Street: "Main", Zip: 10115},
+		Previous:            []TestMappingAddressDTO{{Street: "Old", Zip: 1}},
+		Scores:              map[string]int32{"math": 90},
+		Tags:                [2]string{"a", "b"},
+		ADMIN:               true,
+		Secret:              "secret",
+	}
+
+	user := TestMappingUser{Phone: "123"}
+	unmapped := make([]string, 0)
+
+	err := CopyStruct(&user, dto, UnmappedFields(&unmapped))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &TestMappingAudit{CreatedBy: "system"}, user.TestMappingAudit)
+	assert.Equal(t, int64(42), user.ID)
+	assert.Equal(t, "Anna", user.Name)
+	assert.Equal(t, "anna@test.com", user.Email)
+	assert.Equal(t, TestMappingStatus("active"), user.Status)
+	assert.Equal(t, TestMappingAddress{Street: "Main", Zip: 10115}, user.Address)
+	assert.Equal(t, []*TestMappingAddress{{Street: "Old", Zip: 1}}, user.Previous)
+	assert.Equal(t, map[string]float64{"math": 90}, user.Scores)
+	assert.Equal(t, []string{"a", "b"}, user.Tags)
+	assert.True(t, user.Admin)
+	assert.Equal(t, "secret", user.Secret)
+	assert.Equal(t, "123", user.Phone)
+	assert.Equal(t, []string{"Phone"}, unmapped)
+}
+
+func TestCopyStruct_ReverseDirection(t *testing.T) {
+	user := TestMappingUser{
+		TestMappingAudit: &TestMappingAudit{CreatedBy: "system"},
+		ID:               7,
+		Name:             "Bob",
+		Email:            "bob@test.com",
+		Status:           "inactive",
+		Address:          TestMappingAddress{Street: "Side", Zip: 20095},
+		Tags:             []string{"x"},
+		Secret:           "secret",
+	}
+
+	dto := TestMappingUserDTO{}
+	unmapped := make([]string, 0)
+
+	err := CopyStruct(&dto, &user, UnmappedFields(&unmapped))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "system", dto.CreatedBy)
+	assert.Equal(t, int32(7), dto.ID)
+	assert.Equal(t, "Bob", dto.FullName)
+	assert.Equal(t, "bob@test.com", *dto.Email)
+	assert.Equal(t, "inactive", dto.Status)
+	assert.Equal(t, &TestMappingAddressDTO{Street: "Side", Zip: 20095}, dto.Address)
+	assert.Equal(t, [2]string{"x", ""}, dto.Tags)
+	assert.Empty(t, dto.Secret)
+	assert.Empty(t, unmapped)
+}
+
+func TestCopyStruct_Errors(t *testing.T) {
+	user := TestMappingUser{}
+
+	err := CopyStruct(&user, TestMappingUserDTO{}, ErrorOnUnmapped())
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping: unmapped fields: Phone", err.Error())
+
+	err = CopyStruct(&user, struct{ Address string }{"Main"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping 'Address': cannot convert string to reflector.TestMappingAddress", err.Error())
+
+	dto := TestMappingUserDTO{}
+	err = CopyStruct(&dto, TestMappingUser{
+		Previous: []*TestMappingAddress{nil, {Zip: 1 << 40}},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping 'Previous[1].Zip': 1099511627776 overflows int32", err.Error())
+
+	err = CopyStruct(&dto, TestMappingUser{Tags: []string{"a", "b", "c"}})
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping 'Tags': 3 elements exceed [2]string", err.Error())
+
+	assert.Equal(t, "dst should be a non-nil pointer to a struct", CopyStruct(user, dto).Error())
+	assert.Equal(t, "src should be a struct or a non-nil pointer to a struct", CopyStruct(&user, 42).Error())
+}
+
+type testMappingInner struct {
+	X int
+}
+
+type TestMappingEmbeddedPointerDst struct {
+	*testMappingInner
+	Y int
+}
+
+func TestCopyStruct_UnexportedEmbeddedPointer(t *testing.T) {
+	src := struct{ X, Y int }{X: 1, Y: 2}
+
+	dst := TestMappingEmbeddedPointerDst{}
+	err := CopyStruct(&dst, src)
+	assert.NotNil(t, err)
+	assert.Equal(t, "mapping 'X': embedded pointer 'testMappingInner' cannot be allocated", err.Error())
+
+	dst = TestMappingEmbeddedPointerDst{testMappingInner: &testMappingInner{}}
+	err = CopyStruct(&dst, src)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, dst.X)
+	assert.Equal(t, 2, dst.Y)
+}
@@ -0,0 +1,215 @@
+package reflector
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+type fieldMetadata struct {
+	name        string
+	index       []int
+	structField reflect.StructField
+
+	parsedTags atomic.Value
+}
+
+type parsedTags struct {
+	version uint64
+	tags    Tags
+	errors  []*TagError
+}
+
+func newFieldMetadata(name string, index []int, structField reflect.StructField) *fieldMetadata {
+	structField.Index = append([]int(nil), index...)
+
+	return &fieldMetadata{
+		name:        name,
+		index:       index,
+		structField: structField,
+	}
+}
+
+func (f *fieldMetadata) parseTags() *parsedTags {
+	version := atomic.LoadUint64(&tagParsersVersion)
+
+	if parsed, ok := f.parsedTags.Load().(*parsedTags); ok && parsed.version == version {
+		return parsed
+	}
+
+	tags, errs, valid := parseStructTag(f.structField.Name, string(f.structField.Tag))
+
+	if valid != -1 {
+		tags = tags[:valid]
+	}
+
+	parsed := &parsedTags{
+		version: version,
+		tags:    tags,
+		errors:  errs,
+	}
+
+	f.parsedTags.Store(parsed)
+	return parsed
+}
+
+type typeMetadata struct {
+	reflectType reflect.Type
+
+	unboundOnce sync.Once
+	unbound     Type
+
+	fieldsOnce sync.Once
+	fields     []*fieldMetadata
+
+	methodsOnce sync.Once
+	methods     []reflect.Method
+
+	signatureOnce sync.Once
+	parameters    []Type
+	results       []Type
+
+	mu            sync.RWMutex
+	fieldsByName  map[string]*fieldMetadata
+	visibleFields map[string][]*fieldMetadata
+}
+
+var metadataCache sync.Map
+
+func metadataOf(typ reflect.Type) *typeMetadata {
+	if metadata, exists := metadataCache.Load(typ); exists {
+		return metadata.(*typeMetadata)
+	}
+
+	metadata, _ := metadataCache.LoadOrStore(typ, &typeMetadata{
+		reflectType:   typ,
+		fieldsByName:  make(map[string]*fieldMetadata),
+		visibleFields: make(map[string][]*fieldMetadata),
+	})
+
+	return metadata.(*typeMetadata)
+}
+
+func unboundTypeOf(typ reflect.Type) Type {
+	return metadataOf(typ).unboundType()
+}
+
+func (m *typeMetadata) unboundType() Type {
+	m.unboundOnce.Do(func() {
+		m.unbound = typeOf(reflect.PtrTo(m.reflectType), m.reflectType, nil, nil)
+	})
+
+	return m.unbound
+}
+
+func (m *typeMetadata) declaredFields() []*fieldMetadata {
+	m.fieldsOnce.Do(func() {
+		numField := m.reflectType.NumField()
+		m.fields = make([]*fieldMetadata, numField)
+
+		for i := 0; i < numField; i++ {
+			m.fields[i] = newFieldMetadata("", []int{i}, m.reflectType.Field(i))
+		}
+	})
+
+	return m.fields
+}
+
+func (m *typeMetadata) fieldByName(name string) (*fieldMetadata, bool) {
+	m.mu.RLock()
+	metadata, exists := m.fieldsByName[name]
+	m.mu.RUnlock()
+
+	if exists {
+		return metadata, true
+	}
+
+	structField, found := m.reflectType.FieldByName(name)
+	if !found {
+		return nil, false
+	}
+
+	metadata = newFieldMetadata("", structField.Index, structField)
+
+	m.mu.Lock()
+	if existing, exists := m.fieldsByName[name]; exists {
+		metadata = existing
+	} else {
+		m.fieldsByName[name] = metadata
+	}
+	m.mu.Unlock()
+
+	return metadata, true
+}
+
+func (m *typeMetadata) visibleFieldsByTag(key string) []*fieldMetadata {
+	m.mu.RLock()
+	fields, exists := m.visibleFields[key]
+	m.mu.RUnlock()
+
+	if exists {
+		return fields
+	}
+
+	candidates := visibleFieldsOf(m.reflectType, key)
+	fields = make([]*fieldMetadata, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		fields = append(fields, newFieldMetadata(candidate.name, candidate.index, candidate.structField))
+	}
+
+	m.mu.Lock()
+	if existing, exists := m.visibleFields[key]; exists {
+		fields = existing
+	} else {
+		m.visibleFields[key] = fields
+	}
+	m.mu.Unlock()
+
+	return fields
+}
+
+func (m *typeMetadata) methodSet() []reflect.Method {
+	m.methodsOnce.Do(func() {
+		numMethod := m.reflectType.NumMethod()
+		m.methods = make([]reflect.Method, numMethod)
+
+		for i := 0; i < numMethod; i++ {
+			m.methods[i] = m.reflectType.Method(i)
+		}
+	})
+
+	return m.methods
+}
+
+func (m *typeMetadata) methodByName(name string) (reflect.Method, bool) {
+	methods := m.methodSet()
+
+	for _, method := range methods {
+		if method.Name == name {
+			return method, true
+		}
+	}
+
+	return reflect.Method{}, false
+}
+
+func (m *typeMetadata) signature() ([]Type, []Type) {
+	m.signatureOnce.Do(func() {
+		numIn := m.reflectType.NumIn()
+		m.parameters = make([]Type, numIn)
+
+		for i := 0; i < numIn; i++ {
+			m.parameters[i] = unboundTypeOf(m.reflectType.In(i))
+		}
+
+		numOut := m.reflectType.NumOut()
+		m.results = make([]Type, numOut)
+
+		for i := 0; i < numOut; i++ {
+			m.results[i] = unboundTypeOf(m.reflectType.Out(i))
+		}
+	})
+
+	return m.parameters, m.results
+}
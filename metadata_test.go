@@ -0,0 +1,104 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type TestMetadataStruct struct {
+	Name string `json:"name" yaml:"name"`
+	Age  int    `json:"age,omitempty"`
+}
+
+func (t TestMetadataStruct) Greet(prefix string) string {
+	return prefix + t.Name
+}
+
+func TestMetadataOf_ReturnsSameDescriptor(t *testing.T) {
+	typ := reflect.TypeOf(TestMetadataStruct{})
+
+	assert.Same(t, metadataOf(typ), metadataOf(typ))
+	assert.Same(t, metadataOf(typ).declaredFields()[0], metadataOf(typ).declaredFields()[0])
+	assert.Equal(t, TypeOf[TestMetadataStruct](), TypeOf[TestMetadataStruct]())
+}
+
+func TestMetadataOf_SharedAcrossValueViews(t *testing.T) {
+	first := TestMetadataStruct{Name: "Anna"}
+	second := TestMetadataStruct{Name: "Bob"}
+
+	firstFields := ToStruct(ToPointer(TypeOfAny(&first)).Elem()).Fields()
+	secondFields := ToStruct(ToPointer(TypeOfAny(&second)).Elem()).Fields()
+
+	assert.Same(t, firstFields[0].(*field).metadata, secondFields[0].(*field).metadata)
+
+	firstValue, _ := firstFields[0].Value()
+	secondValue, _ := secondFields[0].Value()
+	assert.Equal(t, "Anna", firstValue)
+	assert.Equal(t, "Bob", secondValue)
+
+	firstTags := firstFields[1].Tags()
+	secondTags := secondFields[1].Tags()
+	assert.Equal(t, firstTags, secondTags)
+
+	firstField, _ := ToStruct(ToPointer(TypeOfAny(&first)).Elem()).FieldByName("Age")
+	secondField, _ := ToStruct(ToPointer(TypeOfAny(&second)).Elem()).FieldByName("Age")
+	assert.Same(t, firstField.(*field).metadata, secondField.(*field).metadata)
+}
+
+func TestMetadataOf_ConcurrentAccess(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			val := TestMetadataStruct{Name: "Anna"}
+			structType := ToStruct(ToPointer(TypeOfAny(&val)).Elem())
+
+			assert.Len(t, structType.Fields(), 2)
+			assert.Len(t, structType.VisibleFieldsByTag("yaml"), 2)
+
+			ageField, exists := structType.FieldByName("Age")
+			assert.True(t, exists)
+
+			tag, exists := ageField.Tags().Find("json")
+			assert.True(t, exists)
+			assert.True(t, tag.HasOption("omitempty"))
+
+			method, exists := structType.MethodByName("Greet")
+			assert.True(t, exists)
+			assert.Len(t, method.Parameters(), 1)
+			assert.Len(t, method.Results(), 1)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestMetadataOf_TagsAreNotShared(t *testing.T) {
+	tags := ToStruct(TypeOf[TestMetadataStruct]()).Fields()[0].Tags()
+	assert.Len(t, tags, 2)
+
+	tags[0] = nil
+
+	tags = ToStruct(TypeOf[TestMetadataStruct]()).Fields()[0].Tags()
+	assert.NotNil(t, tags[0])
+	assert.Equal(t, "json", tags[0].Name())
+}
+
+func TestMetadataOf_FieldByNameMissesAreNotCached(t *testing.T) {
+	structType := ToStruct(TypeOf[TestMetadataStruct]())
+
+	_, exists := structType.FieldByName("Unknown")
+	assert.False(t, exists)
+
+	metadata := metadataOf(reflect.TypeOf(TestMetadataStruct{}))
+	metadata.mu.RLock()
+	_, cached := metadata.fieldsByName["Unknown"]
+	metadata.mu.RUnlock()
+	assert.False(t, cached)
+}
@@ -4,12 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
 )
 
 type Method interface {
 	Type
 	IsExported() bool
 	Receiver() Type
+	HasPointerReceiver() bool
+	DeclaringType() Type
 	Parameters() []Type
 	NumParameter() int
 	Results() []Type
@@ -71,6 +74,14 @@ func (m *methodType) ReflectValue() *reflect.Value {
 	return &m.reflectMethod.Func
 }
 
+func (m *methodType) Kind() Kind {
+	return KindMethod
+}
+
+func (m *methodType) UnderlyingKind() Kind {
+	return KindMethod
+}
+
 func (m *methodType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -119,23 +130,34 @@ func (m *methodType) IsExported() bool {
 }
 
 func (m *methodType) Receiver() Type {
-	return nil
-}
+	if IsInterface(m.parent) || !m.HasPointerReceiver() {
+		return unboundTypeOf(m.parent.ReflectType())
+	}
 
-func (m *methodType) Parameters() []Type {
-	parameters := make([]Type, 0)
-	numIn := m.ReflectType().NumIn()
+	return unboundTypeOf(reflect.PtrTo(m.parent.ReflectType()))
+}
 
-	index := 1
+func (m *methodType) HasPointerReceiver() bool {
 	if IsInterface(m.parent) {
-		index = 0
+		return false
 	}
 
-	for ; index < numIn; index++ {
-		typ := m.ReflectType().In(index)
-		parameters = append(parameters, typeOf(nil, typ, nil, nil))
+	_, exists := m.parent.ReflectType().MethodByName(m.reflectMethod.Name)
+	return !exists
+}
+
+func (m *methodType) DeclaringType() Type {
+	return unboundTypeOf(declaringTypeOf(m.parent.ReflectType(), m.reflectMethod.Name))
+}
+
+func (m *methodType) Parameters() []Type {
+	parameters, _ := metadataOf(m.ReflectType()).signature()
+
+	if !IsInterface(m.parent) {
+		parameters = parameters[1:]
 	}
-	return parameters
+
+	return append(make([]Type, 0, len(parameters)), parameters...)
 }
 
 func (m *methodType) NumParameter() int {
@@ -147,14 +169,8 @@ func (m *methodType) NumParameter() int {
 }
 
 func (m *methodType) Results() []Type {
-	results := make([]Type, 0)
-	numOut := m.ReflectType().NumOut()
-
-	for index := 0; index < numOut; index++ {
-		typ := m.ReflectType().Out(index)
-		results = append(results, typeOf(nil, typ, nil, nil))
-	}
-	return results
+	_, results := metadataOf(m.ReflectType()).signature()
+	return append(make([]Type, 0, len(results)), results...)
 }
 
 func (m *methodType) NumResult() int {
@@ -173,7 +189,7 @@ func (m *methodType) Invoke(args ...any) ([]any, error) {
 		return nil, errors.New("value reference is nil")
 	}
 
-	if parent.Parent() != nil {
+	if parent.Parent() != nil && parent.Parent().ReflectType() == reflect.PtrTo(parent.ReflectType()) {
 		reflectValue = parent.Parent().ReflectValue()
 	}
 
@@ -223,18 +239,19 @@ func (m *methodType) Invoke(args ...any) ([]any, error) {
 
 	outputs := make([]any, 0)
 
-	if parent.Parent() == nil {
-		var pointer reflect.Value
-		if IsInterface(parent) {
-			inputs = append([]reflect.Value{*reflectValue}, inputs...)
-		} else {
-			pointer = reflect.New(parent.ReflectType())
-			pointer.Elem().Set(*reflectValue)
-			inputs = append([]reflect.Value{pointer}, inputs...)
+	if IsInterface(parent) {
+		inputs = append([]reflect.Value{*reflectValue}, inputs...)
+	} else {
+		receiver, err := receiverOf(*reflectValue, parent.ReflectType())
+		if err != nil {
+			return nil, err
 		}
 
-	} else {
-		inputs = append([]reflect.Value{*reflectValue}, inputs...)
+		inputs = append([]reflect.Value{receiver}, inputs...)
+	}
+
+	if !IsInterface(parent) && m.reflectMethod.Type.In(0).Kind() != reflect.Ptr && inputs[0].Kind() == reflect.Ptr {
+		inputs[0] = inputs[0].Elem()
 	}
 
 	var results []reflect.Value
@@ -255,3 +272,131 @@ func (m *methodType) Invoke(args ...any) ([]any, error) {
 func (m *methodType) ReflectMethod() reflect.Method {
 	return m.reflectMethod
 }
+
+func receiverOf(val reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	if !val.CanInterface() {
+		return reflect.Value{}, errors.New("value cannot be accessed")
+	}
+
+	if val.Type() == reflect.PtrTo(typ) {
+		if val.IsNil() {
+			return reflect.Value{}, errors.New("value reference is nil")
+		}
+
+		return val, nil
+	}
+
+	if val.CanAddr() {
+		return val.Addr(), nil
+	}
+
+	pointer := reflect.New(val.Type())
+	pointer.Elem().Set(val)
+	return pointer, nil
+}
+
+func declaringTypeOf(typ reflect.Type, name string) reflect.Type {
+	if typ.Kind() != reflect.Struct {
+		return typ
+	}
+
+	current := make([]reflect.Type, 0)
+	next := []reflect.Type{typ}
+	visited := make(map[reflect.Type]bool)
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		declaring := make([]reflect.Type, 0)
+
+		for _, candidate := range current {
+			if visited[candidate] {
+				continue
+			}
+
+			visited[candidate] = true
+
+			if declaresMethod(candidate, name) {
+				declaring = append(declaring, candidate)
+				continue
+			}
+
+			embeddedTypes := embeddedTypesWithMethod(candidate, name)
+
+			if len(embeddedTypes) == 0 {
+				declaring = append(declaring, candidate)
+				continue
+			}
+
+			next = append(next, embeddedTypes...)
+		}
+
+		if len(declaring) == 1 {
+			return declaring[0]
+		}
+
+		if len(declaring) > 1 {
+			return typ
+		}
+	}
+
+	return typ
+}
+
+func declaresMethod(typ reflect.Type, name string) bool {
+	if typ.Kind() != reflect.Struct {
+		return true
+	}
+
+	if method, exists := typ.MethodByName(name); exists {
+		return !isWrapperMethod(method)
+	}
+
+	if method, exists := reflect.PtrTo(typ).MethodByName(name); exists {
+		return !isWrapperMethod(method)
+	}
+
+	return false
+}
+
+func isWrapperMethod(method reflect.Method) bool {
+	function := runtime.FuncForPC(method.Func.Pointer())
+
+	if function == nil {
+		return false
+	}
+
+	file, _ := function.FileLine(function.Entry())
+	return file == "<autogenerated>"
+}
+
+func embeddedTypesWithMethod(typ reflect.Type, name string) []reflect.Type {
+	embeddedTypes := make([]reflect.Type, 0)
+
+	if typ.Kind() != reflect.Struct {
+		return embeddedTypes
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+
+		if !structField.Anonymous {
+			continue
+		}
+
+		embeddedType := structField.Type
+		if embeddedType.Kind() == reflect.Ptr {
+			embeddedType = embeddedType.Elem()
+		}
+
+		methodSetType := embeddedType
+		if embeddedType.Kind() != reflect.Interface {
+			methodSetType = reflect.PtrTo(embeddedType)
+		}
+
+		if _, exists := methodSetType.MethodByName(name); exists {
+			embeddedTypes = append(embeddedTypes, embeddedType)
+		}
+	}
+
+	return embeddedTypes
+}
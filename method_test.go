@@ -0,0 +1,195 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+type TestMethodBase struct {
+	ID int
+}
+
+func (b TestMethodBase) Identifier() int {
+	return b.ID
+}
+
+func (b *TestMethodBase) SetIdentifier(id int) {
+	b.ID = id
+}
+
+type TestMethodAudit struct {
+	TestMethodBase
+}
+
+type TestMethodEntity struct {
+	TestMethodAudit
+	*TestMethodOwner
+	io.Reader
+	Name string
+}
+
+func (e TestMethodEntity) Describe() string {
+	return e.Name
+}
+
+func (e *TestMethodEntity) Rename(name string) {
+	e.Name = name
+}
+
+func (e TestMethodEntity) Identifier() int {
+	return -1
+}
+
+type TestMethodDeepest struct{}
+
+func (d TestMethodDeepest) Resolve() string {
+	return "deepest"
+}
+
+type TestMethodDeep struct {
+	TestMethodDeepest
+}
+
+type TestMethodShallow struct{}
+
+func (s TestMethodShallow) Resolve() string {
+	return "shallow"
+}
+
+type TestMethodResolver struct {
+	TestMethodDeep
+	TestMethodShallow
+}
+
+type TestMethodOwner struct {
+	Owner string
+}
+
+func (o *TestMethodOwner) ChangeOwner(owner string) {
+	o.Owner = owner
+}
+
+func TestMethod_Receiver(t *testing.T) {
+	structType := ToStruct(TypeOf[TestMethodEntity]())
+
+	method, exists := structType.MethodByName("Describe")
+	assert.True(t, exists)
+	assert.False(t, method.HasPointerReceiver())
+	assert.Equal(t, "TestMethodEntity", method.Receiver().Name())
+	assert.True(t, IsStruct(method.Receiver()))
+
+	method, exists = structType.MethodByName("Rename")
+	assert.True(t, exists)
+	assert.True(t, method.HasPointerReceiver())
+	assert.Equal(t, "*TestMethodEntity", method.Receiver().Name())
+	assert.True(t, IsPointer(method.Receiver()))
+
+	method, exists = structType.MethodByName("SetIdentifier")
+	assert.True(t, exists)
+	assert.True(t, method.HasPointerReceiver())
+
+	method, exists = structType.MethodByName("ChangeOwner")
+	assert.True(t, exists)
+	assert.False(t, method.HasPointerReceiver())
+
+	counter := ToCustom(TypeOf[TestCounter]())
+
+	method, exists = counter.MethodByName("Add")
+	assert.True(t, exists)
+	assert.True(t, method.HasPointerReceiver())
+	assert.Equal(t, "*TestCounter", method.Receiver().Name())
+
+	method, exists = counter.MethodByName("Doubled")
+	assert.True(t, exists)
+	assert.False(t, method.HasPointerReceiver())
+	assert.Equal(t, "TestCounter", method.Receiver().Name())
+
+	iface := ToInterface(TypeOf[io.Reader]())
+	method = iface.Methods()[0]
+	assert.False(t, method.HasPointerReceiver())
+	assert.Equal(t, "Reader", method.Receiver().Name())
+}
+
+func TestMethod_DeclaringType(t *testing.T) {
+	structType := ToStruct(TypeOf[TestMethodEntity]())
+
+	testCases := map[string]string{
+		"Describe":      "TestMethodEntity",
+		"Rename":        "TestMethodEntity",
+		"Identifier":    "TestMethodEntity",
+		"SetIdentifier": "TestMethodBase",
+		"ChangeOwner":   "TestMethodOwner",
+		"Read":          "Reader",
+	}
+
+	for name, declaringType := range testCases {
+		method, exists := structType.MethodByName(name)
+		assert.True(t, exists, name)
+		assert.Equal(t, declaringType, method.DeclaringType().Name(), name)
+	}
+
+	audit := ToStruct(TypeOf[TestMethodAudit]())
+	method, exists := audit.MethodByName("Identifier")
+	assert.True(t, exists)
+	assert.Equal(t, "TestMethodBase", method.DeclaringType().Name())
+
+	resolver := ToStruct(TypeOf[TestMethodResolver]())
+	method, exists = resolver.MethodByName("Resolve")
+	assert.True(t, exists)
+	assert.Equal(t, "TestMethodShallow", method.DeclaringType().Name())
+
+	counter := ToCustom(TypeOf[TestCounter]())
+	method, exists = counter.MethodByName("Doubled")
+	assert.True(t, exists)
+	assert.Equal(t, "TestCounter", method.DeclaringType().Name())
+
+	iface := ToInterface(TypeOf[io.ReadCloser]())
+	for _, method = range iface.Methods() {
+		assert.Equal(t, "ReadCloser", method.DeclaringType().Name())
+	}
+}
+
+type TestMethodCounter struct {
+	Value int
+}
+
+func (c TestMethodCounter) Get() int {
+	return c.Value
+}
+
+func (c *TestMethodCounter) Increment() {
+	c.Value++
+}
+
+type TestMethodCounterHolder struct {
+	Counter TestMethodCounter
+}
+
+func TestMethod_InvokeOnStructField(t *testing.T) {
+	holder := &TestMethodCounterHolder{Counter: TestMethodCounter{Value: 2}}
+	structType := ToStruct(ToPointer(TypeOfAny(holder)).Elem())
+
+	counterField, exists := structType.FieldByName("Counter")
+	assert.True(t, exists)
+
+	counter := ToStruct(counterField.Type())
+
+	get, exists := counter.MethodByName("Get")
+	assert.True(t, exists)
+
+	results, err := get.Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{2}, results)
+
+	increment, exists := counter.MethodByName("Increment")
+	assert.True(t, exists)
+
+	_, err = increment.Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, holder.Counter.Value)
+
+	results, err = get.Invoke()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{3}, results)
+}
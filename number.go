@@ -100,6 +100,14 @@ func (s *signedIntegerType) ReflectValue() *reflect.Value {
 	return s.reflectValue
 }
 
+func (s *signedIntegerType) Kind() Kind {
+	return KindSignedInteger
+}
+
+func (s *signedIntegerType) UnderlyingKind() Kind {
+	return KindSignedInteger
+}
+
 func (s *signedIntegerType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -256,6 +264,14 @@ func (u *unsignedIntegerType) ReflectValue() *reflect.Value {
 	return u.reflectValue
 }
 
+func (u *unsignedIntegerType) Kind() Kind {
+	return KindUnsignedInteger
+}
+
+func (u *unsignedIntegerType) UnderlyingKind() Kind {
+	return KindUnsignedInteger
+}
+
 func (u *unsignedIntegerType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -411,6 +427,14 @@ func (f *floatType) ReflectValue() *reflect.Value {
 	return f.reflectValue
 }
 
+func (f *floatType) Kind() Kind {
+	return KindFloat
+}
+
+func (f *floatType) UnderlyingKind() Kind {
+	return KindFloat
+}
+
 func (f *floatType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -572,6 +596,14 @@ func (c *complexType) ReflectValue() *reflect.Value {
 	return c.reflectValue
 }
 
+func (c *complexType) Kind() Kind {
+	return KindComplex
+}
+
+func (c *complexType) UnderlyingKind() Kind {
+	return KindComplex
+}
+
 func (c *complexType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -0,0 +1,732 @@
+package reflector
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value"`
+}
+
+type PatchError struct {
+	Index  int
+	Op     string
+	Path   string
+	Reason string
+}
+
+func (e *PatchError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("merge patch: %s", e.Reason)
+	}
+
+	return fmt.Sprintf("operation %d (%s '%s'): %s", e.Index, e.Op, e.Path, e.Reason)
+}
+
+type pointerFunc func(container reflect.Value, token string) error
+
+type patcher struct {
+	journal []func()
+}
+
+func ApplyPatch(obj any, operations []PatchOperation) error {
+	root, err := patchRootOf(obj)
+	if err != nil {
+		return err
+	}
+
+	p := &patcher{}
+
+	for index, operation := range operations {
+		if err = p.applyOperation(root, operation); err != nil {
+			p.rollback()
+
+			return &PatchError{
+				Index:  index,
+				Op:     operation.Op,
+				Path:   operation.Path,
+				Reason: err.Error(),
+			}
+		}
+	}
+
+	return nil
+}
+
+func ApplyMergePatch(obj any, patch any) error {
+	root, err := patchRootOf(obj)
+	if err != nil {
+		return err
+	}
+
+	switch raw := patch.(type) {
+	case []byte:
+		if err = json.Unmarshal(raw, &patch); err != nil {
+			return &PatchError{Index: -1, Reason: err.Error()}
+		}
+	case json.RawMessage:
+		if err = json.Unmarshal(raw, &patch); err != nil {
+			return &PatchError{Index: -1, Reason: err.Error()}
+		}
+	}
+
+	p := &patcher{}
+
+	if err = p.mergeValue(root, patch); err != nil {
+		p.rollback()
+		return &PatchError{Index: -1, Reason: err.Error()}
+	}
+
+	return nil
+}
+
+func patchRootOf(obj any) (reflect.Value, error) {
+	val := reflect.ValueOf(obj)
+
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return reflect.Value{}, errors.New("obj should be a non-nil pointer")
+	}
+
+	return val.Elem(), nil
+}
+
+func (p *patcher) save(val reflect.Value) {
+	saved := reflect.New(val.Type()).Elem()
+	saved.Set(val)
+
+	p.journal = append(p.journal, func() {
+		val.Set(saved)
+	})
+}
+
+func (p *patcher) saveMapEntry(container reflect.Value, key reflect.Value) {
+	saved := container.MapIndex(key)
+
+	if saved.IsValid() {
+		copied := reflect.New(saved.Type()).Elem()
+		copied.Set(saved)
+		saved = copied
+	}
+
+	p.journal = append(p.journal, func() {
+		container.SetMapIndex(key, saved)
+	})
+}
+
+func (p *patcher) saveElements(container reflect.Value, from int) {
+	for i := from; i < container.Len(); i++ {
+		p.save(container.Index(i))
+	}
+}
+
+func (p *patcher) rollback() {
+	for i := len(p.journal) - 1; i >= 0; i-- {
+		p.journal[i]()
+	}
+
+	p.journal = nil
+}
+
+func (p *patcher) fieldValue(structField Field, allocate bool) (reflect.Value, error) {
+	f := structField.(*field)
+
+	if allocate {
+		val := *f.structType.reflectValue
+
+		for i, index := range f.metadata.index {
+			if i > 0 && val.Kind() == reflect.Ptr {
+				if val.IsNil() {
+					if val.CanSet() {
+						p.save(val)
+					}
+
+					break
+				}
+
+				val = val.Elem()
+			}
+
+			val = val.Field(index)
+		}
+	}
+
+	return f.walk(f.metadata.index, allocate)
+}
+
+func (p *patcher) setField(structField Field, val any) error {
+	fieldVal, err := p.fieldValue(structField, true)
+	if err != nil {
+		return err
+	}
+
+	if fieldVal.CanSet() {
+		p.save(fieldVal)
+	}
+
+	return structField.SetValueAllocating(val)
+}
+
+func (p *patcher) applyOperation(root reflect.Value, operation PatchOperation) error {
+	tokens, err := parsePointer(operation.Path)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Op {
+	case "add":
+		return p.addValue(root, tokens, reflect.ValueOf(operation.Value))
+	case "remove":
+		return p.removeValue(root, tokens)
+	case "replace":
+		if _, err = p.pointerValue(root, tokens); err != nil {
+			return err
+		}
+
+		return p.addOrReplaceValue(root, tokens, reflect.ValueOf(operation.Value), true)
+	case "move", "copy":
+		from, err := parsePointer(operation.From)
+		if err != nil {
+			return err
+		}
+
+		source, err := p.pointerValue(root, from)
+		if err != nil {
+			return fmt.Errorf("from: %w", err)
+		}
+
+		copied := newCopier(nil).copy(source)
+
+		if operation.Op == "move" {
+			if isPointerPrefix(from, tokens) {
+				return errors.New("a value cannot be moved into one of its children")
+			}
+
+			if err = p.removeValue(root, from); err != nil {
+				return fmt.Errorf("from: %w", err)
+			}
+		}
+
+		return p.addValue(root, tokens, copied)
+	case "test":
+		current, err := p.pointerValue(root, tokens)
+		if err != nil {
+			return err
+		}
+
+		if operation.Value == nil {
+			if !isNilValue(current) {
+				return fmt.Errorf("test failed: expected %v but got %v", operation.Value, interfaceOf(current))
+			}
+
+			return nil
+		}
+
+		expected, err := convertValue(operation.Value, current.Type())
+		if err != nil || !Equal(interfaceOf(current), interfaceOf(expected)) {
+			return fmt.Errorf("test failed: expected %v but got %v", operation.Value, interfaceOf(current))
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported operation '%s'", operation.Op)
+	}
+}
+
+func isNilValue(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return val.IsNil()
+	default:
+		return false
+	}
+}
+
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("pointer '%s' should start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, fmt.Errorf("pointer '%s' has an invalid escape sequence", pointer)
+			}
+		}
+
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+func isPointerPrefix(prefix []string, tokens []string) bool {
+	if len(prefix) >= len(tokens) {
+		return false
+	}
+
+	for i, token := range prefix {
+		if tokens[i] != token {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *patcher) pointerValue(root reflect.Value, tokens []string) (reflect.Value, error) {
+	if len(tokens) == 0 {
+		return root, nil
+	}
+
+	var result reflect.Value
+
+	err := p.locateValue(root, tokens, false, func(container reflect.Value, token string) error {
+		child, err := childValue(container, token)
+		if err != nil {
+			return err
+		}
+
+		result = reflect.New(child.Type()).Elem()
+		result.Set(child)
+		return nil
+	})
+
+	return result, err
+}
+
+func (p *patcher) addValue(root reflect.Value, tokens []string, val reflect.Value) error {
+	return p.addOrReplaceValue(root, tokens, val, false)
+}
+
+func (p *patcher) addOrReplaceValue(root reflect.Value, tokens []string, val reflect.Value, replace bool) error {
+	if len(tokens) == 0 {
+		converted, err := convertReflectValue(val, root.Type())
+		if err != nil {
+			return err
+		}
+
+		p.save(root)
+		root.Set(converted)
+		return nil
+	}
+
+	return p.locateValue(root, tokens, true, func(container reflect.Value, token string) error {
+		switch container.Kind() {
+		case reflect.Struct:
+			structField, err := fieldOf(container, token)
+			if err != nil {
+				return err
+			}
+
+			converted, err := convertReflectValue(val, structField.ReflectStructField().Type)
+			if err != nil {
+				return err
+			}
+
+			return p.setField(structField, interfaceOf(converted))
+		case reflect.Map:
+			key, err := segmentKey(container.Type().Key(), pathSegment{key: token})
+			if err != nil {
+				return err
+			}
+
+			converted, err := convertReflectValue(val, container.Type().Elem())
+			if err != nil {
+				return err
+			}
+
+			if container.IsNil() {
+				p.save(container)
+				container.Set(reflect.MakeMap(container.Type()))
+			}
+
+			p.saveMapEntry(container, key)
+			return mapOf(container).Put(key.Interface(), interfaceOf(converted))
+		case reflect.Slice:
+			converted, err := convertReflectValue(val, container.Type().Elem())
+			if err != nil {
+				return err
+			}
+
+			slice := sliceOf(container)
+
+			if token == "-" && !replace {
+				appended, err := slice.Append(interfaceOf(converted))
+				if err != nil {
+					return err
+				}
+
+				p.save(container)
+				container.Set(reflect.ValueOf(appended))
+				return nil
+			}
+
+			index, err := pointerIndex(container, token, !replace)
+			if err != nil {
+				return err
+			}
+
+			if replace {
+				p.save(container.Index(index))
+				return slice.Set(index, interfaceOf(converted))
+			}
+
+			length := container.Len()
+			appended, err := slice.Append(nil)
+			if err != nil {
+				return err
+			}
+
+			p.save(container)
+			container.Set(reflect.ValueOf(appended))
+			p.saveElements(container.Slice(0, length), index)
+			reflect.Copy(container.Slice(index+1, length+1), container.Slice(index, length))
+			return slice.Set(index, interfaceOf(converted))
+		case reflect.Array:
+			index, err := pointerIndex(container, token, false)
+			if err != nil {
+				return err
+			}
+
+			converted, err := convertReflectValue(val, container.Type().Elem())
+			if err != nil {
+				return err
+			}
+
+			p.save(container.Index(index))
+			container.Index(index).Set(converted)
+			return nil
+		default:
+			return fmt.Errorf("%s has no member '%s'", container.Type().String(), token)
+		}
+	})
+}
+
+func (p *patcher) removeValue(root reflect.Value, tokens []string) error {
+	if len(tokens) == 0 {
+		p.save(root)
+		root.Set(reflect.Zero(root.Type()))
+		return nil
+	}
+
+	return p.locateValue(root, tokens, false, func(container reflect.Value, token string) error {
+		switch container.Kind() {
+		case reflect.Struct:
+			structField, err := fieldOf(container, token)
+			if err != nil {
+				return err
+			}
+
+			return p.setField(structField, nil)
+		case reflect.Map:
+			key, err := childKey(container, token)
+			if err != nil {
+				return err
+			}
+
+			p.saveMapEntry(container, key)
+			return mapOf(container).Delete(key.Interface())
+		case reflect.Slice:
+			index, err := pointerIndex(container, token, false)
+			if err != nil {
+				return err
+			}
+
+			p.save(container)
+			p.saveElements(container, index)
+			container.Set(reflect.AppendSlice(container.Slice(0, index), container.Slice(index+1, container.Len())))
+			return nil
+		default:
+			return fmt.Errorf("member '%s' of %s cannot be removed", token, container.Type().String())
+		}
+	})
+}
+
+func (p *patcher) locateValue(val reflect.Value, tokens []string, allocate bool, fn pointerFunc) error {
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			if !allocate {
+				return fmt.Errorf("%s is nil", val.Type().String())
+			}
+
+			p.save(val)
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+
+		val = val.Elem()
+	}
+
+	if val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return fmt.Errorf("%s is nil", val.Type().String())
+		}
+
+		elem := reflect.New(val.Elem().Type()).Elem()
+		elem.Set(val.Elem())
+
+		if err := p.locateValue(elem, tokens, allocate, fn); err != nil {
+			return err
+		}
+
+		p.save(val)
+		val.Set(elem)
+		return nil
+	}
+
+	if len(tokens) == 1 {
+		return fn(val, tokens[0])
+	}
+
+	switch val.Kind() {
+	case reflect.Map:
+		key, err := childKey(val, tokens[0])
+		if err != nil {
+			return err
+		}
+
+		elem := reflect.New(val.Type().Elem()).Elem()
+		elem.Set(val.MapIndex(key))
+
+		if err = p.locateValue(elem, tokens[1:], allocate, fn); err != nil {
+			return err
+		}
+
+		p.saveMapEntry(val, key)
+		val.SetMapIndex(key, elem)
+		return nil
+	case reflect.Struct:
+		structField, err := fieldOf(val, tokens[0])
+		if err != nil {
+			return err
+		}
+
+		if !structField.IsExported() {
+			return fmt.Errorf("member '%s' is unexported", tokens[0])
+		}
+
+		fieldVal, err := p.fieldValue(structField, allocate)
+		if err != nil {
+			return err
+		}
+
+		return p.locateValue(fieldVal, tokens[1:], allocate, fn)
+	default:
+		child, err := childValue(val, tokens[0])
+		if err != nil {
+			return err
+		}
+
+		return p.locateValue(child, tokens[1:], allocate, fn)
+	}
+}
+
+func childValue(container reflect.Value, token string) (reflect.Value, error) {
+	switch container.Kind() {
+	case reflect.Struct:
+		structField, err := fieldOf(container, token)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return structField.(*field).walk(structField.IndexPath(), false)
+	case reflect.Map:
+		key, err := childKey(container, token)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return container.MapIndex(key), nil
+	case reflect.Slice, reflect.Array:
+		index, err := pointerIndex(container, token, false)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return container.Index(index), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("%s has no member '%s'", container.Type().String(), token)
+	}
+}
+
+func childKey(container reflect.Value, token string) (reflect.Value, error) {
+	key, err := segmentKey(container.Type().Key(), pathSegment{key: token})
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if !container.MapIndex(key).IsValid() {
+		return reflect.Value{}, fmt.Errorf("member '%s' not found", token)
+	}
+
+	return key, nil
+}
+
+func fieldOf(container reflect.Value, token string) (Field, error) {
+	typ := container.Type()
+	structType := ToStruct(typeOf(reflect.PtrTo(typ), typ, &container, nil))
+
+	structField, exists := fieldByVisibleName(structType, token, "json")
+	if !exists {
+		return nil, fmt.Errorf("member '%s' not found", token)
+	}
+
+	return structField, nil
+}
+
+func pointerIndex(container reflect.Value, token string, allowEnd bool) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("index '%s' is not valid", token)
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 {
+		return 0, fmt.Errorf("index '%s' is not valid", token)
+	}
+
+	if index > container.Len() || (index == container.Len() && !allowEnd) {
+		return 0, errors.New("array index out of range")
+	}
+
+	return index, nil
+}
+
+func mapOf(val reflect.Value) Map {
+	return &mapType{
+		reflectType:  val.Type(),
+		reflectValue: &val,
+	}
+}
+
+func sliceOf(val reflect.Value) Slice {
+	return &sliceType{
+		reflectType:  val.Type(),
+		reflectValue: &val,
+	}
+}
+
+func (p *patcher) mergeValue(target reflect.Value, patch any) error {
+	object, isObject := patch.(map[string]any)
+
+	if !isObject {
+		converted, err := convertValue(patch, target.Type())
+		if err != nil {
+			return err
+		}
+
+		p.save(target)
+		target.Set(converted)
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Ptr:
+		if target.IsNil() {
+			p.save(target)
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+
+		return p.mergeValue(target.Elem(), patch)
+	case reflect.Interface:
+		elem := reflect.ValueOf(map[string]any{})
+
+		if !target.IsNil() && target.Elem().Type() == elem.Type() {
+			elem = target.Elem()
+		}
+
+		if !elem.Type().AssignableTo(target.Type()) {
+			break
+		}
+
+		copied := reflect.New(elem.Type()).Elem()
+		copied.Set(elem)
+
+		if err := p.mergeValue(copied, patch); err != nil {
+			return err
+		}
+
+		p.save(target)
+		target.Set(copied)
+		return nil
+	case reflect.Struct:
+		for name, val := range object {
+			structField, err := fieldOf(target, name)
+			if err != nil {
+				continue
+			}
+
+			if val == nil {
+				if err = p.setField(structField, nil); err != nil {
+					return fmt.Errorf("%s: %w", name, err)
+				}
+
+				continue
+			}
+
+			fieldVal, err := p.fieldValue(structField, true)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			if err = p.mergeValue(fieldVal, val); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+
+		return nil
+	case reflect.Map:
+		if target.IsNil() {
+			p.save(target)
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+
+		for name, val := range object {
+			key, err := segmentKey(target.Type().Key(), pathSegment{key: name})
+			if err != nil {
+				return err
+			}
+
+			if val == nil {
+				p.saveMapEntry(target, key)
+
+				if err = mapOf(target).Delete(key.Interface()); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			elem := reflect.New(target.Type().Elem()).Elem()
+			if existing := target.MapIndex(key); existing.IsValid() {
+				elem.Set(existing)
+			}
+
+			if err = p.mergeValue(elem, val); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			p.saveMapEntry(target, key)
+
+			if err = mapOf(target).Put(key.Interface(), interfaceOf(elem)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot merge an object into %s", target.Type().String())
+}
@@ -0,0 +1,305 @@
+package reflector
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type TestPatchAddress struct {
+	City    string `json:"city"`
+	ZipCode string `json:"zip_code,omitempty"`
+}
+
+type TestPatchUser struct {
+	Name     string            `json:"name"`
+	Age      int               `json:"age"`
+	Email    *string           `json:"email"`
+	Tags     []string          `json:"tags"`
+	Address  *TestPatchAddress `json:"address"`
+	Labels   map[string]string `json:"labels"`
+	Scores   map[int]float64   `json:"scores"`
+	Extra    any               `json:"extra"`
+	Internal string            `json:"-"`
+}
+
+func TestApplyPatch_Add(t *testing.T) {
+	user := TestPatchUser{Tags: []string{"a", "c"}}
+
+	err := ApplyPatch(&user, []PatchOperation{
+		{Op: "add", Path: "/name", Value: "anna"},
+		{Op: "add", Path: "/age", Value: float64(42)},
+		{Op: "add", Path: "/email", Value: "anna@test.com"},
+		{Op: "add", Path: "/tags/1", Value: "b"},
+		{Op: "add", Path: "/tags/-", Value: "d"},
+		{Op: "add", Path: "/address/city", Value: "Berlin"},
+		{Op: "add", Path: "/labels/team", Value: "core"},
+		{Op: "add", Path: "/scores/7", Value: 1.5},
+		{Op: "add", Path: "/extra", Value: map[string]any{"key": "value"}},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "anna", user.Name)
+	assert.Equal(t, 42, user.Age)
+	assert.Equal(t, "anna@test.com", *user.Email)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, user.Tags)
+	assert.Equal(t, &TestPatchAddress{City: "Berlin"}, user.Address)
+	assert.Equal(t, map[string]string{"team": "core"}, user.Labels)
+	assert.Equal(t, map[int]float64{7: 1.5}, user.Scores)
+	assert.Equal(t, map[string]any{"key": "value"}, user.Extra)
+}
+
+func TestApplyPatch_RemoveAndReplace(t *testing.T) {
+	user := TestPatchUser{
+		Name:    "anna",
+		Age:     30,
+		Tags:    []string{"a", "b", "c"},
+		Address: &TestPatchAddress{City: "Berlin", ZipCode: "10115"},
+		Labels:  map[string]string{"team": "core", "role": "dev"},
+		Extra:   map[string]any{"nested": map[string]any{"count": float64(1)}},
+	}
+
+	err := ApplyPatch(&user, []PatchOperation{
+		{Op: "remove", Path: "/tags/1"},
+		{Op: "remove", Path: "/labels/role"},
+		{Op: "remove", Path: "/address/zip_code"},
+		{Op: "replace", Path: "/age", Value: 31},
+		{Op: "replace", Path: "/tags/0", Value: "z"},
+		{Op: "replace", Path: "/extra/nested/count", Value: float64(2)},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 31, user.Age)
+	assert.Equal(t, []string{"z", "c"}, user.Tags)
+	assert.Equal(t, map[string]string{"team": "core"}, user.Labels)
+	assert.Equal(t, &TestPatchAddress{City: "Berlin"}, user.Address)
+	assert.Equal(t, map[string]any{"nested": map[string]any{"count": float64(2)}}, user.Extra)
+}
+
+func TestApplyPatch_MoveCopyAndTest(t *testing.T) {
+	user := TestPatchUser{
+		Name:    "anna",
+		Tags:    []string{"a", "b"},
+		Address: &TestPatchAddress{City: "Berlin"},
+		Labels:  map[string]string{"old": "value"},
+	}
+
+	err := ApplyPatch(&user, []PatchOperation{
+		{Op: "test", Path: "/name", Value: "anna"},
+		{Op: "test", Path: "/address", Value: map[string]any{"city": "Berlin"}},
+		{Op: "move", From: "/labels/old", Path: "/labels/new"},
+		{Op: "copy", From: "/tags/0", Path: "/tags/-"},
+		{Op: "copy", From: "/address/city", Path: "/name"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Berlin", user.Name)
+	assert.Equal(t, []string{"a", "b", "a"}, user.Tags)
+	assert.Equal(t, map[string]string{"new": "value"}, user.Labels)
+}
+
+func TestApplyPatch_TestNull(t *testing.T) {
+	user := TestPatchUser{Name: "", Age: 0, Tags: []string{}}
+
+	for _, path := range []string{"/name", "/age", "/tags"} {
+		err := ApplyPatch(&user, []PatchOperation{
+			{Op: "test", Path: path, Value: nil},
+		})
+		assert.NotNil(t, err, path)
+	}
+
+	for _, path := range []string{"/email", "/address", "/labels", "/extra"} {
+		err := ApplyPatch(&user, []PatchOperation{
+			{Op: "test", Path: path, Value: nil},
+		})
+		assert.Nil(t, err, path)
+	}
+}
+
+func TestPatchOperation_MarshalsNullValue(t *testing.T) {
+	data, err := json.Marshal(PatchOperation{Op: "replace", Path: "/email", Value: nil})
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"op":"replace","path":"/email","value":null}`, string(data))
+}
+
+func TestApplyPatch_IsAtomic(t *testing.T) {
+	user := TestPatchUser{Name: "anna", Age: 30}
+
+	err := ApplyPatch(&user, []PatchOperation{
+		{Op: "replace", Path: "/name", Value: "bob"},
+		{Op: "test", Path: "/age", Value: 31},
+	})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "operation 1 (test '/age'): test failed: expected 31 but got 30", err.Error())
+	assert.Equal(t, "anna", user.Name)
+}
+
+func TestApplyPatch_RollsBackEveryChange(t *testing.T) {
+	tags := []string{"a", "b", "c"}
+	user := TestPatchUser{
+		Name:   "anna",
+		Tags:   tags,
+		Labels: map[string]string{"team": "core"},
+	}
+
+	err := ApplyPatch(&user, []PatchOperation{
+		{Op: "add", Path: "/tags/1", Value: "x"},
+		{Op: "remove", Path: "/tags/0"},
+		{Op: "add", Path: "/labels/role", Value: "dev"},
+		{Op: "remove", Path: "/labels/team"},
+		{Op: "add", Path: "/address/city", Value: "Berlin"},
+		{Op: "add", Path: "/email", Value: "anna@test.com"},
+		{Op: "add", Path: "/unknown", Value: 1},
+	})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, user.Tags)
+	assert.Equal(t, []string{"a", "b", "c"}, tags)
+	assert.Equal(t, map[string]string{"team": "core"}, user.Labels)
+	assert.Nil(t, user.Address)
+	assert.Nil(t, user.Email)
+
+	err = ApplyMergePatch(&user, map[string]any{
+		"name":    "bob",
+		"labels":  map[string]any{"team": nil, "role": "dev"},
+		"address": map[string]any{"city": "Berlin"},
+		"age":     "x",
+	})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "anna", user.Name)
+	assert.Equal(t, map[string]string{"team": "core"}, user.Labels)
+	assert.Nil(t, user.Address)
+}
+
+type TestPatchResource struct {
+	Name    string            `json:"name"`
+	Shared  *TestPatchAddress `json:"shared"`
+	Handler func() string     `json:"-"`
+	mu      *sync.Mutex
+}
+
+func TestApplyPatch_KeepsUntouchedPointers(t *testing.T) {
+	shared := &TestPatchAddress{City: "Berlin"}
+	mu := &sync.Mutex{}
+	resource := TestPatchResource{Name: "anna", Shared: shared, mu: mu}
+
+	err := ApplyPatch(&resource, []PatchOperation{
+		{Op: "replace", Path: "/name", Value: "bob"},
+		{Op: "replace", Path: "/shared/city", Value: "Hamburg"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "bob", resource.Name)
+	assert.Same(t, shared, resource.Shared)
+	assert.Same(t, mu, resource.mu)
+	assert.Equal(t, "Hamburg", shared.City)
+
+	err = ApplyMergePatch(&resource, []byte(`{"name": "carol", "shared": {"zip_code": "20095"}}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "carol", resource.Name)
+	assert.Same(t, shared, resource.Shared)
+	assert.Same(t, mu, resource.mu)
+	assert.Equal(t, "20095", shared.ZipCode)
+
+	err = ApplyPatch(&resource, []PatchOperation{
+		{Op: "replace", Path: "/shared/city", Value: "Munich"},
+		{Op: "test", Path: "/name", Value: "dave"},
+	})
+
+	assert.NotNil(t, err)
+	assert.Same(t, shared, resource.Shared)
+	assert.Equal(t, "Hamburg", shared.City)
+}
+
+func TestApplyPatch_Errors(t *testing.T) {
+	user := TestPatchUser{Tags: []string{"a"}}
+
+	testCases := []struct {
+		operation PatchOperation
+		expected  string
+	}{
+		{PatchOperation{Op: "add", Path: "/unknown", Value: 1}, "operation 0 (add '/unknown'): member 'unknown' not found"},
+		{PatchOperation{Op: "add", Path: "/Internal", Value: "x"}, "operation 0 (add '/Internal'): member 'Internal' not found"},
+		{PatchOperation{Op: "add", Path: "/age", Value: "x"}, "operation 0 (add '/age'): cannot convert string to int"},
+		{PatchOperation{Op: "add", Path: "/age", Value: 1.5}, "operation 0 (add '/age'): 1.5 overflows int"},
+		{PatchOperation{Op: "add", Path: "/tags/5", Value: "x"}, "operation 0 (add '/tags/5'): array index out of range"},
+		{PatchOperation{Op: "remove", Path: "/tags/01"}, "operation 0 (remove '/tags/01'): index '01' is not valid"},
+		{PatchOperation{Op: "remove", Path: "/labels/x"}, "operation 0 (remove '/labels/x'): member 'x' not found"},
+		{PatchOperation{Op: "replace", Path: "/address/city", Value: "x"}, "operation 0 (replace '/address/city'): *reflector.TestPatchAddress is nil"},
+		{PatchOperation{Op: "move", From: "/address", Path: "/address/city"}, "operation 0 (move '/address/city'): a value cannot be moved into one of its children"},
+		{PatchOperation{Op: "add", Path: "name", Value: "x"}, "operation 0 (add 'name'): pointer 'name' should start with '/'"},
+		{PatchOperation{Op: "add", Path: "/a~2", Value: "x"}, "operation 0 (add '/a~2'): pointer '/a~2' has an invalid escape sequence"},
+		{PatchOperation{Op: "merge", Path: "/name"}, "operation 0 (merge '/name'): unsupported operation 'merge'"},
+	}
+
+	for _, testCase := range testCases {
+		err := ApplyPatch(&user, []PatchOperation{testCase.operation})
+		assert.NotNil(t, err)
+		assert.Equal(t, testCase.expected, err.Error())
+	}
+
+	assert.Equal(t, "obj should be a non-nil pointer", ApplyPatch(user, nil).Error())
+}
+
+func TestApplyPatch_EscapedPointer(t *testing.T) {
+	labels := map[string]int{}
+
+	err := ApplyPatch(&labels, []PatchOperation{
+		{Op: "add", Path: "/a~1b", Value: 1},
+		{Op: "add", Path: "/c~0d", Value: 2},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"a/b": 1, "c~d": 2}, labels)
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	email := "anna@test.com"
+	user := TestPatchUser{
+		Name:    "anna",
+		Age:     30,
+		Email:   &email,
+		Tags:    []string{"a"},
+		Address: &TestPatchAddress{City: "Berlin", ZipCode: "10115"},
+		Labels:  map[string]string{"team": "core", "role": "dev"},
+		Extra:   map[string]any{"keep": true, "drop": true},
+	}
+
+	err := ApplyMergePatch(&user, []byte(`{
+		"age": 31,
+		"email": null,
+		"tags": ["x", "y"],
+		"address": {"zip_code": "20095"},
+		"labels": {"role": null, "level": "senior"},
+		"extra": {"drop": null, "added": 1},
+		"unknown": "ignored"
+	}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "anna", user.Name)
+	assert.Equal(t, 31, user.Age)
+	assert.Nil(t, user.Email)
+	assert.Equal(t, []string{"x", "y"}, user.Tags)
+	assert.Equal(t, &TestPatchAddress{City: "Berlin", ZipCode: "20095"}, user.Address)
+	assert.Equal(t, map[string]string{"team": "core", "level": "senior"}, user.Labels)
+	assert.Equal(t, map[string]any{"keep": true, "added": float64(1)}, user.Extra)
+}
+
+func TestApplyMergePatch_Errors(t *testing.T) {
+	user := TestPatchUser{Name: "anna"}
+
+	err := ApplyMergePatch(&user, map[string]any{"name": "bob", "age": "old"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "merge patch: age: cannot convert string to int", err.Error())
+	assert.Equal(t, "anna", user.Name)
+
+	err = ApplyMergePatch(&user, []byte(`{`))
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "obj should be a non-nil pointer", ApplyMergePatch(nil, map[string]any{}).Error())
+}
@@ -0,0 +1,504 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type PathError struct {
+	Path    string
+	Segment string
+	Reason  string
+}
+
+func (e *PathError) Error() string {
+	if e.Segment == "" {
+		return fmt.Sprintf("path '%s': %s", e.Path, e.Reason)
+	}
+
+	return fmt.Sprintf("path '%s': segment '%s': %s", e.Path, e.Segment, e.Reason)
+}
+
+type pathSegmentKind int
+
+const (
+	fieldSegment pathSegmentKind = iota
+	indexSegment
+	wildcardSegment
+	recursiveSegment
+	filterSegment
+)
+
+type pathSegment struct {
+	kind   pathSegmentKind
+	key    string
+	quoted bool
+	text   string
+	filter *pathFilter
+	mapKey reflect.Value
+}
+
+func Get(obj any, path string) (any, error) {
+	val, err := resolvePath(reflect.ValueOf(obj), path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !val.CanInterface() {
+		return nil, &PathError{Path: path, Reason: "value is unexported"}
+	}
+
+	return val.Interface(), nil
+}
+
+func Resolve(obj any, path string) (Type, error) {
+	val, err := resolvePath(reflect.ValueOf(obj), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return typeOf(reflect.PtrTo(val.Type()), val.Type(), &val, nil), nil
+}
+
+func Set(obj any, path string, val any) error {
+	root := reflect.ValueOf(obj)
+
+	if root.Kind() != reflect.Ptr || root.IsNil() {
+		return &PathError{Path: path, Reason: "obj should be a non-nil pointer"}
+	}
+
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	return setPath(path, root, segments, val)
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	segments := make([]pathSegment, 0)
+	offset := 0
+
+	for offset < len(path) {
+		switch path[offset] {
+		case '.':
+			if offset == 0 || offset+1 >= len(path) {
+				return nil, &PathError{Path: path, Segment: path[offset:], Reason: "missing field name"}
+			}
+
+			offset++
+			fallthrough
+		default:
+			if offset > 0 && path[offset-1] != '.' {
+				return nil, &PathError{Path: path, Segment: path[offset:], Reason: "unexpected character"}
+			}
+
+			end := offset
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+
+			if end == offset {
+				return nil, &PathError{Path: path, Segment: path[offset:], Reason: "missing field name"}
+			}
+
+			segment := pathSegment{
+				kind: fieldSegment,
+				key:  path[offset:end],
+				text: path[offset:end],
+			}
+
+			if segment.key == "*" {
+				segment.kind = wildcardSegment
+			} else if segment.key == "**" {
+				segment.kind = recursiveSegment
+			}
+
+			segments = append(segments, segment)
+			offset = end
+		case '[':
+			segment, end, err := parseBracketSegment(path, offset)
+			if err != nil {
+				return nil, err
+			}
+
+			segments = append(segments, segment)
+			offset = end
+		}
+	}
+
+	return segments, nil
+}
+
+func parseBracketSegment(path string, offset int) (pathSegment, int, error) {
+	end := offset + 1
+
+	if end < len(path) && path[end] == '"' {
+		end++
+		for end < len(path) && path[end] != '"' {
+			if path[end] == '\\' {
+				end++
+			}
+			end++
+		}
+
+		if end >= len(path) {
+			return pathSegment{}, 0, &PathError{Path: path, Segment: path[offset:], Reason: "unterminated quoted key"}
+		}
+
+		key, err := strconv.Unquote(path[offset+1 : end+1])
+		if err != nil {
+			return pathSegment{}, 0, &PathError{Path: path, Segment: path[offset:], Reason: "invalid quoted key"}
+		}
+
+		end++
+		if end >= len(path) || path[end] != ']' {
+			return pathSegment{}, 0, &PathError{Path: path, Segment: path[offset:], Reason: "missing ']'"}
+		}
+
+		return pathSegment{
+			kind:   indexSegment,
+			key:    key,
+			quoted: true,
+			text:   path[offset : end+1],
+		}, end + 1, nil
+	}
+
+	if end < len(path) && path[end] == '?' {
+		return parseFilterSegment(path, offset)
+	}
+
+	closeIndex := strings.IndexByte(path[offset:], ']')
+	if closeIndex == -1 {
+		return pathSegment{}, 0, &PathError{Path: path, Segment: path[offset:], Reason: "missing ']'"}
+	}
+
+	end = offset + closeIndex
+	if end == offset+1 {
+		return pathSegment{}, 0, &PathError{Path: path, Segment: path[offset : end+1], Reason: "missing index"}
+	}
+
+	segment := pathSegment{
+		kind: indexSegment,
+		key:  path[offset+1 : end],
+		text: path[offset : end+1],
+	}
+
+	if segment.key == "*" {
+		segment.kind = wildcardSegment
+	}
+
+	return segment, end + 1, nil
+}
+
+func resolvePath(root reflect.Value, path string) (reflect.Value, error) {
+	if !root.IsValid() {
+		return reflect.Value{}, &PathError{Path: path, Reason: "obj should not be nil"}
+	}
+
+	segments, err := parsePath(path)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	val := root
+
+	for _, segment := range segments {
+		if segment.kind > indexSegment {
+			return reflect.Value{}, &PathError{Path: path, Segment: segment.text, Reason: "wildcards and filters are only supported in queries"}
+		}
+
+		val, err = indirectValue(val)
+		if err != nil {
+			return reflect.Value{}, &PathError{Path: path, Segment: segment.text, Reason: err.Error()}
+		}
+
+		val, err = segmentValue(val, segment)
+		if err != nil {
+			return reflect.Value{}, &PathError{Path: path, Segment: segment.text, Reason: err.Error()}
+		}
+	}
+
+	return val, nil
+}
+
+func indirectValue(val reflect.Value) (reflect.Value, error) {
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return reflect.Value{}, fmt.Errorf("%s is nil", val.Type().String())
+		}
+
+		val = val.Elem()
+	}
+
+	return val, nil
+}
+
+func segmentValue(val reflect.Value, segment pathSegment) (reflect.Value, error) {
+	switch val.Kind() {
+	case reflect.Struct:
+		if segment.kind != fieldSegment {
+			return reflect.Value{}, fmt.Errorf("cannot index %s", val.Type().String())
+		}
+
+		return segmentField(val, segment, false)
+	case reflect.Slice, reflect.Array:
+		index, err := segmentIndex(val, segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return val.Index(index), nil
+	case reflect.Map:
+		key, err := segmentKey(val.Type().Key(), segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		elem := val.MapIndex(key)
+		if !elem.IsValid() {
+			return reflect.Value{}, fmt.Errorf("element with key '%v' does not exist", key.Interface())
+		}
+
+		return elem, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("cannot traverse %s", val.Type().String())
+	}
+}
+
+func segmentField(val reflect.Value, segment pathSegment, allocate bool) (reflect.Value, error) {
+	structType := ToStruct(typeOf(reflect.PtrTo(val.Type()), val.Type(), &val, nil))
+
+	structField, exists := structType.FieldByName(segment.key)
+	if !exists {
+		return reflect.Value{}, fmt.Errorf("field does not exist in %s", val.Type().String())
+	}
+
+	if !structField.IsExported() {
+		return reflect.Value{}, errors.New("the field is unexported")
+	}
+
+	return structField.(*field).walk(structField.IndexPath(), allocate)
+}
+
+func segmentIndex(val reflect.Value, segment pathSegment) (int, error) {
+	if segment.kind != indexSegment || segment.quoted {
+		return -1, fmt.Errorf("%s requires an integer index", val.Type().String())
+	}
+
+	index, err := strconv.Atoi(segment.key)
+	if err != nil {
+		return -1, fmt.Errorf("%s requires an integer index", val.Type().String())
+	}
+
+	if index < 0 || index >= val.Len() {
+		return -1, errors.New("array index out of range")
+	}
+
+	return index, nil
+}
+
+func segmentKey(keyType reflect.Type, segment pathSegment) (reflect.Value, error) {
+	if segment.mapKey.IsValid() && segment.mapKey.Type() == keyType {
+		return segment.mapKey, nil
+	}
+
+	key := reflect.New(keyType).Elem()
+
+	var err error
+
+	switch keyType.Kind() {
+	case reflect.String:
+		key.SetString(segment.key)
+		return key, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var val int64
+		if val, err = strconv.ParseInt(segment.key, 10, keyType.Bits()); err == nil {
+			key.SetInt(val)
+			return key, nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var val uint64
+		if val, err = strconv.ParseUint(segment.key, 10, keyType.Bits()); err == nil {
+			key.SetUint(val)
+			return key, nil
+		}
+	case reflect.Float32, reflect.Float64:
+		var val float64
+		if val, err = strconv.ParseFloat(segment.key, keyType.Bits()); err == nil {
+			key.SetFloat(val)
+			return key, nil
+		}
+	case reflect.Bool:
+		var val bool
+		if val, err = strconv.ParseBool(segment.key); err == nil {
+			key.SetBool(val)
+			return key, nil
+		}
+	}
+
+	return reflect.Value{}, fmt.Errorf("key '%s' cannot be converted to %s", segment.key, keyType.String())
+}
+
+func setPath(path string, val reflect.Value, segments []pathSegment, newVal any) error {
+	if len(segments) == 0 {
+		for val.Kind() == reflect.Ptr && newVal != nil && !reflect.TypeOf(newVal).AssignableTo(val.Type()) {
+			if val.IsNil() {
+				if !val.CanSet() {
+					break
+				}
+
+				val.Set(reflect.New(val.Type().Elem()))
+			}
+
+			val = val.Elem()
+		}
+
+		if err := assignValue(val, newVal); err != nil {
+			return &PathError{Path: path, Reason: err.Error()}
+		}
+
+		return nil
+	}
+
+	segment := segments[0]
+	fail := func(reason string) error {
+		return &PathError{Path: path, Segment: segment.text, Reason: reason}
+	}
+
+	if segment.kind > indexSegment {
+		return fail("wildcards and filters are only supported in queries")
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr:
+		if val.IsNil() {
+			if !val.CanSet() {
+				return fail(fmt.Sprintf("%s is nil", val.Type().String()))
+			}
+
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+
+		return setPath(path, val.Elem(), segments, newVal)
+	case reflect.Interface:
+		if val.IsNil() {
+			return fail(fmt.Sprintf("%s is nil", val.Type().String()))
+		}
+
+		elem := val.Elem()
+		if elem.Kind() == reflect.Ptr {
+			return setPath(path, elem, segments, newVal)
+		}
+
+		if !val.CanSet() {
+			return fail("value cannot be set")
+		}
+
+		copied := reflect.New(elem.Type()).Elem()
+		copied.Set(elem)
+
+		if err := setPath(path, copied, segments, newVal); err != nil {
+			return err
+		}
+
+		val.Set(copied)
+		return nil
+	case reflect.Struct:
+		if segment.kind != fieldSegment {
+			return fail(fmt.Sprintf("cannot index %s", val.Type().String()))
+		}
+
+		fieldVal, err := segmentField(val, segment, true)
+		if err != nil {
+			return fail(err.Error())
+		}
+
+		return setPath(path, fieldVal, segments[1:], newVal)
+	case reflect.Slice, reflect.Array:
+		index, err := segmentIndex(val, segment)
+		if err != nil {
+			return fail(err.Error())
+		}
+
+		return setPath(path, val.Index(index), segments[1:], newVal)
+	case reflect.Map:
+		key, err := segmentKey(val.Type().Key(), segment)
+		if err != nil {
+			return fail(err.Error())
+		}
+
+		if val.IsNil() {
+			if !val.CanSet() {
+				return fail(fmt.Sprintf("%s is nil", val.Type().String()))
+			}
+
+			val.Set(reflect.MakeMap(val.Type()))
+		}
+
+		elem := reflect.New(val.Type().Elem()).Elem()
+		if existing := val.MapIndex(key); existing.IsValid() {
+			elem.Set(existing)
+		}
+
+		if err = setPath(path, elem, segments[1:], newVal); err != nil {
+			return err
+		}
+
+		val.SetMapIndex(key, elem)
+		return nil
+	default:
+		return fail(fmt.Sprintf("cannot traverse %s", val.Type().String()))
+	}
+}
+
+func assignValue(target reflect.Value, val any) error {
+	if !target.CanSet() {
+		return errors.New("value cannot be set")
+	}
+
+	if val == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	source := reflect.ValueOf(val)
+
+	if source.Type().AssignableTo(target.Type()) {
+		target.Set(source)
+		return nil
+	}
+
+	if isNumberKind(source.Kind()) && isNumberKind(target.Kind()) {
+		converted, err := convertReflectValue(source, target.Type())
+		if err != nil {
+			return err
+		}
+
+		target.Set(converted)
+		return nil
+	}
+
+	if source.Kind() == target.Kind() {
+		if source.Type().ConvertibleTo(target.Type()) {
+			target.Set(source.Convert(target.Type()))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("expected %s but got %s", target.Type().String(), source.Type().String())
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,197 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestPathItem struct {
+	Price    float64
+	Quantity *int
+}
+
+type TestPathOrder struct {
+	ID    int
+	Items map[string]TestPathItem
+	Tags  [2]string
+}
+
+type TestPathCustomer struct {
+	TestPromotedMiddleStruct
+	Name     string
+	Orders   []*TestPathOrder
+	Extra    any
+	Counts   map[int]int
+	internal string
+}
+
+func newTestPathCustomer() *TestPathCustomer {
+	return &TestPathCustomer{
+		Name: "anyName",
+		Orders: []*TestPathOrder{
+			{ID: 1},
+			{ID: 2, Items: map[string]TestPathItem{"sku": {Price: 10.5}}, Tags: [2]string{"a", "b"}},
+		},
+		Extra:    TestPathItem{Price: 3},
+		Counts:   map[int]int{7: 70},
+		internal: "anyInternalValue",
+	}
+}
+
+func TestGet(t *testing.T) {
+	customer := newTestPathCustomer()
+
+	val, err := Get(customer, "Name")
+	assert.Nil(t, err)
+	assert.Equal(t, "anyName", val)
+
+	val, err = Get(customer, "Orders[1].Items[\"sku\"].Price")
+	assert.Nil(t, err)
+	assert.Equal(t, 10.5, val)
+
+	val, err = Get(customer, "Orders[1].Items.sku.Price")
+	assert.Nil(t, err)
+	assert.Equal(t, 10.5, val)
+
+	val, err = Get(customer, "Orders[1].Tags[1]")
+	assert.Nil(t, err)
+	assert.Equal(t, "b", val)
+
+	val, err = Get(*customer, "Extra.Price")
+	assert.Nil(t, err)
+	assert.Equal(t, float64(3), val)
+
+	val, err = Get(customer, "Counts[7]")
+	assert.Nil(t, err)
+	assert.Equal(t, 70, val)
+
+	val, err = Get(customer, "")
+	assert.Nil(t, err)
+	assert.Equal(t, customer, val)
+
+	typ, err := Resolve(customer, "Orders[1].Items")
+	assert.Nil(t, err)
+	assert.True(t, IsMap(typ))
+	assert.True(t, typ.HasValue())
+
+	val, err = Get(customer, "Orders[5].ID")
+	assert.Nil(t, val)
+	assert.EqualError(t, err, "path 'Orders[5].ID': segment '[5]': array index out of range")
+
+	_, err = Get(customer, "Orders[0].Items[\"sku\"]")
+	assert.EqualError(t, err, "path 'Orders[0].Items[\"sku\"]': segment '[\"sku\"]': element with key 'sku' does not exist")
+
+	_, err = Get(customer, "Orders[0].Unknown")
+	assert.EqualError(t, err, "path 'Orders[0].Unknown': segment 'Unknown': field does not exist in reflector.TestPathOrder")
+
+	_, err = Get(customer, "internal")
+	assert.EqualError(t, err, "path 'internal': segment 'internal': the field is unexported")
+
+	_, err = Get(customer, "InnerField")
+	assert.EqualError(t, err, "path 'InnerField': segment 'InnerField': embedded pointer 'TestPromotedInnerStruct' is nil")
+
+	_, err = Get(customer, "Counts[x]")
+	assert.EqualError(t, err, "path 'Counts[x]': segment '[x]': key 'x' cannot be converted to int")
+
+	_, err = Get(customer, "Name.Length")
+	assert.EqualError(t, err, "path 'Name.Length': segment 'Length': cannot traverse string")
+
+	_, err = Get(customer, "Orders[")
+	assert.EqualError(t, err, "path 'Orders[': segment '[': missing ']'")
+
+	_, err = Get(customer, "Orders..ID")
+	assert.NotNil(t, err)
+
+	_, err = Get(nil, "Name")
+	assert.EqualError(t, err, "path 'Name': obj should not be nil")
+}
+
+func TestSet(t *testing.T) {
+	customer := newTestPathCustomer()
+
+	err := Set(customer, "Name", "anotherName")
+	assert.Nil(t, err)
+	assert.Equal(t, "anotherName", customer.Name)
+
+	err = Set(customer, "Orders[1].Items[\"sku\"].Price", 20)
+	assert.Nil(t, err)
+	assert.Equal(t, 20.0, customer.Orders[1].Items["sku"].Price)
+
+	err = Set(customer, "Orders[0].Items[\"new\"].Quantity", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, *customer.Orders[0].Items["new"].Quantity)
+
+	err = Set(customer, "Orders[1].Tags[0]", "c")
+	assert.Nil(t, err)
+	assert.Equal(t, [2]string{"c", "b"}, customer.Orders[1].Tags)
+
+	err = Set(customer, "Extra.Price", 5.5)
+	assert.Nil(t, err)
+	assert.Equal(t, TestPathItem{Price: 5.5}, customer.Extra)
+
+	err = Set(customer, "InnerField", "anyInnerValue")
+	assert.Nil(t, err)
+	assert.Equal(t, "anyInnerValue", customer.InnerField)
+
+	err = Set(customer, "Counts[8]", 80)
+	assert.Nil(t, err)
+	assert.Equal(t, 80, customer.Counts[8])
+
+	err = Set(customer, "Orders[0]", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, customer.Orders[0])
+
+	err = Set(customer, "Name", 5)
+	assert.EqualError(t, err, "path 'Name': expected string but got int")
+
+	err = Set(customer, "Orders[9].ID", 5)
+	assert.EqualError(t, err, "path 'Orders[9].ID': segment '[9]': array index out of range")
+
+	err = Set(customer, "internal", "x")
+	assert.EqualError(t, err, "path 'internal': segment 'internal': the field is unexported")
+
+	err = Set(*customer, "Name", "x")
+	assert.EqualError(t, err, "path 'Name': obj should be a non-nil pointer")
+
+	order := TestPathOrder{}
+	err = Set(&order, "", TestPathOrder{ID: 9})
+	assert.Nil(t, err)
+	assert.Equal(t, 9, order.ID)
+}
+
+type TestPathLimits struct {
+	Small uint8
+	Count int
+	Ratio float32
+}
+
+func TestSet_NumericConversions(t *testing.T) {
+	limits := &TestPathLimits{}
+
+	err := Set(limits, "Small", 200)
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(200), limits.Small)
+
+	err = Set(limits, "Small", 300)
+	assert.EqualError(t, err, "path 'Small': 300 overflows uint8")
+	assert.Equal(t, uint8(200), limits.Small)
+
+	err = Set(limits, "Small", -1)
+	assert.EqualError(t, err, "path 'Small': -1 overflows uint8")
+
+	err = Set(limits, "Count", 4.0)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, limits.Count)
+
+	err = Set(limits, "Count", 4.5)
+	assert.EqualError(t, err, "path 'Count': 4.5 overflows int")
+	assert.Equal(t, 4, limits.Count)
+
+	err = Set(limits, "Ratio", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(2), limits.Ratio)
+
+	err = Set(limits, "Ratio", 1e300)
+	assert.EqualError(t, err, "path 'Ratio': 1e+300 overflows float32")
+	assert.Equal(t, float32(2), limits.Ratio)
+}
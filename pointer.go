@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type Pointer interface {
@@ -12,8 +13,11 @@ type Pointer interface {
 }
 
 type pointer struct {
-	base Type
+	baseOnce sync.Once
+	base     Type
 
+	parent       Type
+	nilType      reflect.Type
 	reflectType  reflect.Type
 	reflectValue *reflect.Value
 }
@@ -21,16 +25,16 @@ type pointer struct {
 func (p *pointer) Name() string {
 	var builder strings.Builder
 	builder.WriteString("*")
-	builder.WriteString(p.base.Name())
+	builder.WriteString(p.baseType().Name())
 	return builder.String()
 }
 
 func (p *pointer) PackageName() string {
-	return p.base.PackageName()
+	return p.baseType().PackageName()
 }
 
 func (p *pointer) PackagePath() string {
-	return p.base.PackagePath()
+	return p.baseType().PackagePath()
 }
 
 func (p *pointer) HasValue() bool {
@@ -70,12 +74,20 @@ func (p *pointer) ReflectValue() *reflect.Value {
 	return p.reflectValue
 }
 
+func (p *pointer) Kind() Kind {
+	return KindPointer
+}
+
+func (p *pointer) UnderlyingKind() Kind {
+	return KindPointer
+}
+
 func (p *pointer) Parent() Type {
 	return nil
 }
 
 func (p *pointer) Elem() Type {
-	return p.base
+	return p.baseType()
 }
 
 func (p *pointer) Compare(another Type) bool {
@@ -87,11 +99,11 @@ func (p *pointer) Compare(another Type) bool {
 }
 
 func (p *pointer) IsInstantiable() bool {
-	return p.base.IsInstantiable()
+	return p.baseType().IsInstantiable()
 }
 
 func (p *pointer) Instantiate() (Value, error) {
-	return p.base.Instantiate()
+	return p.baseType().Instantiate()
 }
 
 func (p *pointer) CanConvert(typ Type) bool {
@@ -125,3 +137,18 @@ func (p *pointer) Convert(typ Type) (Value, error) {
 		val,
 	}, nil
 }
+
+func (p *pointer) baseType() Type {
+	p.baseOnce.Do(func() {
+		if p.parent != nil && p.reflectValue != nil {
+			p.base = typeOf(p.nilType, p.reflectType.Elem(), p.reflectValue, p)
+		} else if p.reflectValue != nil {
+			elem := p.reflectValue.Elem()
+			p.base = typeOf(p.nilType, p.reflectType.Elem(), &elem, p)
+		} else {
+			p.base = typeOf(p.nilType, p.reflectType.Elem(), nil, p)
+		}
+	})
+
+	return p.base
+}
@@ -0,0 +1,458 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Match interface {
+	Path() string
+	Type() Type
+	Value() (any, error)
+	Set(val any) error
+}
+
+type match struct {
+	root     reflect.Value
+	path     string
+	segments []pathSegment
+	val      reflect.Value
+}
+
+func (m *match) Path() string {
+	return m.path
+}
+
+func (m *match) Type() Type {
+	val := m.val
+	return typeOf(reflect.PtrTo(val.Type()), val.Type(), &val, nil)
+}
+
+func (m *match) Value() (any, error) {
+	if !m.val.CanInterface() {
+		return nil, errors.New("value is unexported")
+	}
+
+	return m.val.Interface(), nil
+}
+
+func (m *match) Set(val any) error {
+	if m.root.Kind() != reflect.Ptr {
+		return &PathError{Path: m.path, Reason: "obj should be a non-nil pointer"}
+	}
+
+	if m.root.IsNil() {
+		return &PathError{Path: m.path, Reason: "obj should be a non-nil pointer"}
+	}
+
+	return setPath(m.path, m.root, m.segments, val)
+}
+
+type filterOperator string
+
+const (
+	equalOperator          filterOperator = "=="
+	notEqualOperator       filterOperator = "!="
+	greaterOrEqualOperator filterOperator = ">="
+	lessOrEqualOperator    filterOperator = "<="
+	greaterOperator        filterOperator = ">"
+	lessOperator           filterOperator = "<"
+)
+
+var filterOperators = []filterOperator{
+	equalOperator,
+	notEqualOperator,
+	greaterOrEqualOperator,
+	lessOrEqualOperator,
+	greaterOperator,
+	lessOperator,
+}
+
+type pathFilter struct {
+	path     string
+	operator filterOperator
+	operand  any
+}
+
+func Query(obj any, query string) ([]Match, error) {
+	root := reflect.ValueOf(obj)
+
+	if !root.IsValid() {
+		return nil, &PathError{Path: query, Reason: "obj should not be nil"}
+	}
+
+	segments, err := parsePath(query)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match, 0)
+	evaluateQuery(root, root, queryNode{}, segments, &matches)
+	return matches, nil
+}
+
+func evaluateQuery(root reflect.Value, val reflect.Value, node queryNode, segments []pathSegment, matches *[]Match) {
+	if len(segments) == 0 {
+		*matches = append(*matches, &match{
+			root:     root,
+			path:     node.path,
+			segments: node.segments,
+			val:      val,
+		})
+		return
+	}
+
+	segment := segments[0]
+
+	if segment.kind == recursiveSegment {
+		visited := make(map[visit]bool)
+		evaluateDescendants(root, val, node, segments[1:], visited, matches)
+		return
+	}
+
+	val, err := indirectValue(val)
+	if err != nil {
+		return
+	}
+
+	switch segment.kind {
+	case wildcardSegment:
+		for _, child := range childrenOf(val, node) {
+			evaluateQuery(root, child.val, child, segments[1:], matches)
+		}
+	case filterSegment:
+		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array && val.Kind() != reflect.Map {
+			return
+		}
+
+		for _, child := range childrenOf(val, node) {
+			if segment.filter.matches(child.val) {
+				evaluateQuery(root, child.val, child, segments[1:], matches)
+			}
+		}
+	default:
+		child, err := segmentValue(val, segment)
+		if err != nil {
+			return
+		}
+
+		evaluateQuery(root, child, childNode(val, node, segment), segments[1:], matches)
+	}
+}
+
+func evaluateDescendants(root reflect.Value, val reflect.Value, node queryNode, segments []pathSegment, visited map[visit]bool, matches *[]Match) {
+	keys := make([]visit, 0)
+	elem := val
+
+	for {
+		if key, tracked := visitOf(elem); tracked {
+			keys = append(keys, key)
+		}
+
+		if (elem.Kind() != reflect.Ptr && elem.Kind() != reflect.Interface) || elem.IsNil() {
+			break
+		}
+
+		elem = elem.Elem()
+	}
+
+	for _, key := range keys {
+		if visited[key] {
+			return
+		}
+	}
+
+	for _, key := range keys {
+		visited[key] = true
+	}
+
+	evaluateQuery(root, val, node, segments, matches)
+
+	for _, child := range childrenOf(elem, node) {
+		evaluateDescendants(root, child.val, child, segments, visited, matches)
+	}
+
+	for _, key := range keys {
+		delete(visited, key)
+	}
+}
+
+type queryNode struct {
+	path     string
+	segments []pathSegment
+	val      reflect.Value
+}
+
+func (n queryNode) child(path string, segment pathSegment, val reflect.Value) queryNode {
+	segments := make([]pathSegment, len(n.segments)+1)
+	copy(segments, n.segments)
+	segments[len(n.segments)] = segment
+
+	return queryNode{
+		path:     path,
+		segments: segments,
+		val:      val,
+	}
+}
+
+func childrenOf(val reflect.Value, node queryNode) []queryNode {
+	children := make([]queryNode, 0)
+
+	switch val.Kind() {
+	case reflect.Struct:
+		numField := val.NumField()
+
+		for i := 0; i < numField; i++ {
+			structField := val.Type().Field(i)
+			if !structField.IsExported() {
+				continue
+			}
+
+			segment := pathSegment{kind: fieldSegment, key: structField.Name, text: structField.Name}
+			children = append(children, node.child(joinPath(node.path, structField.Name), segment, val.Field(i)))
+		}
+	case reflect.Slice, reflect.Array:
+		length := val.Len()
+
+		for i := 0; i < length; i++ {
+			text := "[" + strconv.Itoa(i) + "]"
+			segment := pathSegment{kind: indexSegment, key: strconv.Itoa(i), text: text}
+			children = append(children, node.child(node.path+text, segment, val.Index(i)))
+		}
+	case reflect.Map:
+		keys := val.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+
+		for _, key := range keys {
+			text := "[" + formatKey(key) + "]"
+			segment := pathSegment{kind: indexSegment, key: formatKey(key), text: text, mapKey: key}
+			children = append(children, node.child(node.path+text, segment, val.MapIndex(key)))
+		}
+	}
+
+	return children
+}
+
+func childNode(val reflect.Value, node queryNode, segment pathSegment) queryNode {
+	switch val.Kind() {
+	case reflect.Struct:
+		return node.child(joinPath(node.path, segment.key), segment, reflect.Value{})
+	case reflect.Map:
+		key, err := segmentKey(val.Type().Key(), segment)
+		if err != nil {
+			return node.child(node.path+segment.text, segment, reflect.Value{})
+		}
+
+		segment.mapKey = key
+		return node.child(node.path+"["+formatKey(key)+"]", segment, reflect.Value{})
+	default:
+		return node.child(node.path+"["+segment.key+"]", segment, reflect.Value{})
+	}
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func formatKey(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return strconv.Quote(key.String())
+	}
+
+	return fmt.Sprint(key)
+}
+
+func parseFilterSegment(path string, offset int) (pathSegment, int, error) {
+	end := offset + 2
+	quoted := false
+
+	for end < len(path) && (quoted || path[end] != ']') {
+		if path[end] == '\\' && quoted {
+			end++
+		} else if path[end] == '"' {
+			quoted = !quoted
+		}
+		end++
+	}
+
+	if end >= len(path) {
+		return pathSegment{}, 0, &PathError{Path: path, Segment: path[offset:], Reason: "missing ']'"}
+	}
+
+	text := path[offset : end+1]
+	expression := path[offset+2 : end]
+
+	filter, err := parseFilter(expression)
+	if err != nil {
+		return pathSegment{}, 0, &PathError{Path: path, Segment: text, Reason: err.Error()}
+	}
+
+	return pathSegment{
+		kind:   filterSegment,
+		key:    expression,
+		text:   text,
+		filter: filter,
+	}, end + 1, nil
+}
+
+func parseFilter(expression string) (*pathFilter, error) {
+	quoted := false
+
+	for i := 0; i < len(expression); i++ {
+		if expression[i] == '\\' && quoted {
+			i++
+			continue
+		}
+
+		if expression[i] == '"' {
+			quoted = !quoted
+			continue
+		}
+
+		if quoted {
+			continue
+		}
+
+		for _, operator := range filterOperators {
+			if !strings.HasPrefix(expression[i:], string(operator)) {
+				continue
+			}
+
+			left := strings.TrimSpace(expression[:i])
+			right := strings.TrimSpace(expression[i+len(operator):])
+
+			if left == "" {
+				return nil, errors.New("missing filter field")
+			}
+
+			if _, err := parsePath(left); err != nil {
+				return nil, fmt.Errorf("invalid filter field '%s'", left)
+			}
+
+			operand, err := parseFilterOperand(right)
+			if err != nil {
+				return nil, err
+			}
+
+			return &pathFilter{
+				path:     left,
+				operator: operator,
+				operand:  operand,
+			}, nil
+		}
+	}
+
+	return nil, errors.New("missing filter operator")
+}
+
+func parseFilterOperand(operand string) (any, error) {
+	switch {
+	case operand == "":
+		return nil, errors.New("missing filter operand")
+	case operand == "nil" || operand == "null":
+		return nil, nil
+	case operand == "true" || operand == "false":
+		return operand == "true", nil
+	case strings.HasPrefix(operand, "\""):
+		val, err := strconv.Unquote(operand)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter operand '%s'", operand)
+		}
+
+		return val, nil
+	default:
+		val, err := strconv.ParseFloat(operand, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter operand '%s'", operand)
+		}
+
+		return val, nil
+	}
+}
+
+func (f *pathFilter) matches(val reflect.Value) bool {
+	fieldVal, err := resolvePath(val, f.path)
+	if err != nil {
+		return false
+	}
+
+	if f.operand == nil {
+		isNil := false
+		switch fieldVal.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			isNil = fieldVal.IsNil()
+		}
+
+		return (f.operator == equalOperator && isNil) || (f.operator == notEqualOperator && !isNil)
+	}
+
+	fieldVal, err = indirectValue(fieldVal)
+	if err != nil {
+		return false
+	}
+
+	var comparison int
+
+	switch operand := f.operand.(type) {
+	case float64:
+		var number float64
+
+		switch {
+		case fieldVal.CanInt():
+			number = float64(fieldVal.Int())
+		case fieldVal.CanUint():
+			number = float64(fieldVal.Uint())
+		case fieldVal.CanFloat():
+			number = fieldVal.Float()
+		default:
+			return false
+		}
+
+		if number < operand {
+			comparison = -1
+		} else if number > operand {
+			comparison = 1
+		}
+	case string:
+		if fieldVal.Kind() != reflect.String {
+			return false
+		}
+
+		comparison = strings.Compare(fieldVal.String(), operand)
+	case bool:
+		if fieldVal.Kind() != reflect.Bool {
+			return false
+		}
+
+		if fieldVal.Bool() != operand {
+			return f.operator == notEqualOperator
+		}
+
+		return f.operator == equalOperator || f.operator == greaterOrEqualOperator || f.operator == lessOrEqualOperator
+	}
+
+	switch f.operator {
+	case equalOperator:
+		return comparison == 0
+	case notEqualOperator:
+		return comparison != 0
+	case greaterOrEqualOperator:
+		return comparison >= 0
+	case lessOrEqualOperator:
+		return comparison <= 0
+	case greaterOperator:
+		return comparison > 0
+	default:
+		return comparison < 0
+	}
+}
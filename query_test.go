@@ -0,0 +1,192 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestQueryUser struct {
+	ID      int
+	Email   string
+	Active  bool
+	Friend  *TestQueryUser
+	Profile map[string]TestQueryProfile
+}
+
+type TestQueryProfile struct {
+	ID    int
+	Score float64
+}
+
+type TestQueryGroup struct {
+	ID    int
+	Users []*TestQueryUser
+	Items []TestPathItem
+}
+
+func newTestQueryGroup() *TestQueryGroup {
+	first := &TestQueryUser{ID: 1, Email: "first@codnect.io", Active: true}
+	second := &TestQueryUser{ID: 2, Email: "second@codnect.io", Friend: first, Profile: map[string]TestQueryProfile{
+		"main": {ID: 3, Score: 1.5},
+	}}
+	first.Friend = second
+
+	return &TestQueryGroup{
+		ID:    10,
+		Users: []*TestQueryUser{first, second},
+		Items: []TestPathItem{{Price: 5}, {Price: 15}, {Price: 25}},
+	}
+}
+
+func TestQueryWildcard(t *testing.T) {
+	group := newTestQueryGroup()
+
+	matches, err := Query(group, "Users[*].Email")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 2)
+
+	assert.Equal(t, "Users[0].Email", matches[0].Path())
+	val, err := matches[0].Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "first@codnect.io", val)
+	assert.True(t, IsString(matches[0].Type()))
+
+	assert.Equal(t, "Users[1].Email", matches[1].Path())
+
+	for _, match := range matches {
+		err = match.Type().SetValue("redacted")
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, "redacted", group.Users[0].Email)
+	assert.Equal(t, "redacted", group.Users[1].Email)
+
+	matches, err = Query(group, "Users[0].*")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 5)
+}
+
+func TestQueryRecursive(t *testing.T) {
+	group := newTestQueryGroup()
+
+	matches, err := Query(group, "**.ID")
+	assert.Nil(t, err)
+
+	paths := make([]string, 0)
+	for _, match := range matches {
+		paths = append(paths, match.Path())
+	}
+
+	assert.Equal(t, []string{
+		"ID",
+		"Users[0].ID",
+		"Users[0].Friend.ID",
+		"Users[0].Friend.Profile[\"main\"].ID",
+		"Users[1].ID",
+		"Users[1].Friend.ID",
+		"Users[1].Profile[\"main\"].ID",
+	}, paths)
+
+	err = matches[3].Set(7)
+	assert.Nil(t, err)
+	assert.Equal(t, 7, group.Users[1].Profile["main"].ID)
+}
+
+func TestQueryFilter(t *testing.T) {
+	group := newTestQueryGroup()
+
+	matches, err := Query(group, "Items[?Price>10]")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 2)
+	assert.Equal(t, "Items[1]", matches[0].Path())
+	assert.Equal(t, "Items[2]", matches[1].Path())
+
+	matches, err = Query(group, "Items[?Price <= 15].Price")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 2)
+
+	for _, match := range matches {
+		assert.Nil(t, match.Set(0))
+	}
+
+	assert.Equal(t, []TestPathItem{{Price: 0}, {Price: 0}, {Price: 25}}, group.Items)
+
+	matches, err = Query(group, "Users[?Email==\"second@codnect.io\"].ID")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "Users[1].ID", matches[0].Path())
+
+	matches, err = Query(group, "Users[?Active==true].ID")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "Users[0].ID", matches[0].Path())
+
+	matches, err = Query(group, "Users[?Profile!=nil].Profile[*].Score")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "Users[1].Profile[\"main\"].Score", matches[0].Path())
+
+	matches, err = Query(group, "Users[?Friend.ID==2].Email")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "Users[0].Email", matches[0].Path())
+}
+
+func TestQueryErrors(t *testing.T) {
+	group := newTestQueryGroup()
+
+	matches, err := Query(group, "Items[?Price]")
+	assert.Nil(t, matches)
+	assert.EqualError(t, err, "path 'Items[?Price]': segment '[?Price]': missing filter operator")
+
+	_, err = Query(group, "Items[?Price>abc]")
+	assert.EqualError(t, err, "path 'Items[?Price>abc]': segment '[?Price>abc]': invalid filter operand 'abc'")
+
+	_, err = Query(group, "Items[?Price>10")
+	assert.EqualError(t, err, "path 'Items[?Price>10': segment '[?Price>10': missing ']'")
+
+	_, err = Query(nil, "Items")
+	assert.NotNil(t, err)
+
+	matches, err = Query(group, "Unknown[*]")
+	assert.Nil(t, err)
+	assert.Empty(t, matches)
+
+	_, err = Get(group, "Items[*].Price")
+	assert.EqualError(t, err, "path 'Items[*].Price': segment '[*]': wildcards and filters are only supported in queries")
+
+	err = Set(group, "**.ID", 1)
+	assert.EqualError(t, err, "path '**.ID': segment '**': wildcards and filters are only supported in queries")
+}
+
+type TestQueryPoint struct {
+	X int
+	Y int
+}
+
+type TestQueryGrid struct {
+	Cells map[TestQueryPoint]TestQueryProfile
+}
+
+func TestQuerySetWithStructKeys(t *testing.T) {
+	grid := &TestQueryGrid{Cells: map[TestQueryPoint]TestQueryProfile{
+		{X: 1, Y: 2}: {ID: 1, Score: 0.5},
+	}}
+
+	matches, err := Query(grid, "Cells[*].Score")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "Cells[{1 2}].Score", matches[0].Path())
+
+	err = matches[0].Set(2.5)
+	assert.Nil(t, err)
+	assert.Equal(t, TestQueryProfile{ID: 1, Score: 2.5}, grid.Cells[TestQueryPoint{X: 1, Y: 2}])
+
+	matches, err = Query(grid, "Cells[*]")
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+
+	err = matches[0].Set(TestQueryProfile{ID: 7})
+	assert.Nil(t, err)
+	assert.Equal(t, TestQueryProfile{ID: 7}, grid.Cells[TestQueryPoint{X: 1, Y: 2}])
+}
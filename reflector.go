@@ -186,6 +186,32 @@ func ToComplex(typ Type) Complex {
 	return nil
 }
 
+func IsUintptr(typ Type) bool {
+	_, ok := typ.(*uintptrType)
+	return ok
+}
+
+func ToUintptr(typ Type) Uintptr {
+	if uintptrTyp, ok := typ.(*uintptrType); ok {
+		return uintptrTyp
+	}
+
+	return nil
+}
+
+func IsUnsafePointer(typ Type) bool {
+	_, ok := typ.(*unsafePointerType)
+	return ok
+}
+
+func ToUnsafePointer(typ Type) UnsafePointer {
+	if pointerTyp, ok := typ.(*unsafePointerType); ok {
+		return pointerTyp
+	}
+
+	return nil
+}
+
 func IsNumber(typ Type) bool {
 	return IsInteger(typ) || IsFloat(typ) || IsComplex(typ)
 }
@@ -0,0 +1,225 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type typeRegistry struct {
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+}
+
+var registry = &typeRegistry{
+	types: make(map[string]reflect.Type),
+}
+
+func Register[T any]() error {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	return registry.register(typ)
+}
+
+func RegisterType(typ Type) error {
+	if typ == nil {
+		return errors.New("typ should not be nil")
+	}
+
+	return registry.register(typ.ReflectType())
+}
+
+func Lookup(name string) (Type, bool) {
+	registry.mu.RLock()
+	typ, exists := registry.types[name]
+	registry.mu.RUnlock()
+
+	if !exists {
+		return nil, false
+	}
+
+	return typeOf(reflect.PtrTo(typ), typ, nil, nil), true
+}
+
+func Types() []Type {
+	return registry.filter(func(typ reflect.Type) bool {
+		return true
+	})
+}
+
+func TypesInPackage(path string) []Type {
+	return registry.filter(func(typ reflect.Type) bool {
+		return packagePathOf(typ) == path
+	})
+}
+
+func (r *typeRegistry) register(typ reflect.Type) error {
+	if typ == nil {
+		return errors.New("typ should not be nil")
+	}
+
+	name := qualifiedNameOf(typ)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if registered, exists := r.types[name]; exists {
+		if registered == typ {
+			return nil
+		}
+
+		return fmt.Errorf("another type is already registered with name '%s'", name)
+	}
+
+	r.types[name] = typ
+	return nil
+}
+
+func (r *typeRegistry) filter(predicate func(typ reflect.Type) bool) []Type {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.types))
+
+	for name, typ := range r.types {
+		if predicate(typ) {
+			names = append(names, name)
+		}
+	}
+
+	reflectTypes := make([]reflect.Type, 0, len(names))
+	sort.Strings(names)
+
+	for _, name := range names {
+		reflectTypes = append(reflectTypes, r.types[name])
+	}
+	r.mu.RUnlock()
+
+	types := make([]Type, 0, len(reflectTypes))
+
+	for _, typ := range reflectTypes {
+		types = append(types, typeOf(reflect.PtrTo(typ), typ, nil, nil))
+	}
+
+	return types
+}
+
+func qualifiedNameOf(typ reflect.Type) string {
+	if typ.Name() != "" {
+		if typ.PkgPath() != "" {
+			return typ.PkgPath() + "." + typ.Name()
+		}
+
+		return typ.Name()
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return "*" + qualifiedNameOf(typ.Elem())
+	case reflect.Slice:
+		return "[]" + qualifiedNameOf(typ.Elem())
+	case reflect.Array:
+		return "[" + strconv.Itoa(typ.Len()) + "]" + qualifiedNameOf(typ.Elem())
+	case reflect.Map:
+		return "map[" + qualifiedNameOf(typ.Key()) + "]" + qualifiedNameOf(typ.Elem())
+	case reflect.Chan:
+		switch typ.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + qualifiedNameOf(typ.Elem())
+		case reflect.SendDir:
+			return "chan<- " + qualifiedNameOf(typ.Elem())
+		}
+
+		if typ.Elem().Kind() == reflect.Chan && typ.Elem().Name() == "" && typ.Elem().ChanDir() == reflect.RecvDir {
+			return "chan (" + qualifiedNameOf(typ.Elem()) + ")"
+		}
+
+		return "chan " + qualifiedNameOf(typ.Elem())
+	case reflect.Func:
+		return "func" + qualifiedSignatureOf(typ)
+	case reflect.Struct:
+		fields := make([]string, typ.NumField())
+
+		for i := range fields {
+			structField := typ.Field(i)
+			field := qualifiedNameOf(structField.Type)
+
+			if !structField.Anonymous {
+				field = qualifiedMemberNameOf(structField.Name, structField.PkgPath) + " " + field
+			}
+
+			if structField.Tag != "" {
+				field += " " + strconv.Quote(string(structField.Tag))
+			}
+
+			fields[i] = field
+		}
+
+		return qualifiedBodyOf("struct", fields)
+	case reflect.Interface:
+		methods := make([]string, typ.NumMethod())
+
+		for i := range methods {
+			method := typ.Method(i)
+			methods[i] = qualifiedMemberNameOf(method.Name, method.PkgPath) + qualifiedSignatureOf(method.Type)
+		}
+
+		return qualifiedBodyOf("interface", methods)
+	}
+
+	return typ.String()
+}
+
+func qualifiedMemberNameOf(name string, pkgPath string) string {
+	if pkgPath == "" {
+		return name
+	}
+
+	return pkgPath + "." + name
+}
+
+func qualifiedSignatureOf(typ reflect.Type) string {
+	in := make([]string, 0, typ.NumIn())
+
+	for i := 0; i < typ.NumIn(); i++ {
+		if typ.IsVariadic() && i == typ.NumIn()-1 {
+			in = append(in, "..."+qualifiedNameOf(typ.In(i).Elem()))
+			continue
+		}
+
+		in = append(in, qualifiedNameOf(typ.In(i)))
+	}
+
+	signature := "(" + strings.Join(in, ", ") + ")"
+
+	out := make([]string, typ.NumOut())
+	for i := range out {
+		out[i] = qualifiedNameOf(typ.Out(i))
+	}
+
+	switch len(out) {
+	case 0:
+		return signature
+	case 1:
+		return signature + " " + out[0]
+	default:
+		return signature + " (" + strings.Join(out, ", ") + ")"
+	}
+}
+
+func qualifiedBodyOf(keyword string, members []string) string {
+	if len(members) == 0 {
+		return keyword + " {}"
+	}
+
+	return keyword + " { " + strings.Join(members, "; ") + " }"
+}
+
+func packagePathOf(typ reflect.Type) string {
+	for typ.Kind() == reflect.Ptr && typ.Name() == "" {
+		typ = typ.Elem()
+	}
+
+	return typ.PkgPath()
+}
@@ -0,0 +1,191 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	htmltemplate "html/template"
+	"sync"
+	"testing"
+	"text/template"
+)
+
+type TestRegistryStruct1 struct {
+	Name string
+}
+
+type TestRegistryStruct2 struct {
+}
+
+type TestRegistryNumber int
+
+func TestRegister(t *testing.T) {
+	err := Register[TestRegistryStruct1]()
+	assert.Nil(t, err)
+
+	err = Register[TestRegistryStruct1]()
+	assert.Nil(t, err)
+
+	err = RegisterType(TypeOf[TestRegistryNumber]())
+	assert.Nil(t, err)
+
+	err = RegisterType(nil)
+	assert.NotNil(t, err)
+
+	typ, exists := Lookup("codnect.io/reflector.TestRegistryStruct1")
+	assert.True(t, exists)
+	assert.True(t, IsStruct(typ))
+	assert.Equal(t, "TestRegistryStruct1", typ.Name())
+	assert.Equal(t, "reflector", typ.PackageName())
+	assert.True(t, typ.Compare(TypeOf[TestRegistryStruct1]()))
+	assert.False(t, typ.HasValue())
+
+	structType := ToStruct(typ)
+	assert.Equal(t, 1, structType.NumField())
+
+	typ, exists = Lookup("codnect.io/reflector.TestRegistryNumber")
+	assert.True(t, exists)
+	assert.True(t, IsCustom(typ))
+	assert.Equal(t, "TestRegistryNumber", typ.Name())
+
+	typ, exists = Lookup("codnect.io/reflector.TestRegistryStruct2")
+	assert.False(t, exists)
+	assert.Nil(t, typ)
+}
+
+func TestRegisterBuiltinAndUnnamedTypes(t *testing.T) {
+	err := Register[string]()
+	assert.Nil(t, err)
+
+	err = Register[*TestRegistryStruct2]()
+	assert.Nil(t, err)
+
+	typ, exists := Lookup("string")
+	assert.True(t, exists)
+	assert.True(t, IsString(typ))
+
+	typ, exists = Lookup("*codnect.io/reflector.TestRegistryStruct2")
+	assert.True(t, exists)
+	assert.True(t, IsPointer(typ))
+	assert.Equal(t, "*TestRegistryStruct2", typ.Name())
+}
+
+func TestRegisterCompositeTypesFromDifferentPackages(t *testing.T) {
+	err := Register[[]*template.Template]()
+	assert.Nil(t, err)
+
+	err = Register[[]*htmltemplate.Template]()
+	assert.Nil(t, err)
+
+	err = Register[map[string]struct{ Template *template.Template }]()
+	assert.Nil(t, err)
+
+	typ, exists := Lookup("[]*text/template.Template")
+	assert.True(t, exists)
+	assert.True(t, typ.Compare(TypeOf[[]*template.Template]()))
+
+	typ, exists = Lookup("[]*html/template.Template")
+	assert.True(t, exists)
+	assert.True(t, typ.Compare(TypeOf[[]*htmltemplate.Template]()))
+
+	typ, exists = Lookup("map[string]struct { Template *text/template.Template }")
+	assert.True(t, exists)
+	assert.True(t, IsMap(typ))
+
+	_, exists = Lookup("[]*template.Template")
+	assert.False(t, exists)
+}
+
+func TestTypesInPackage(t *testing.T) {
+	err := Register[TestRegistryStruct1]()
+	assert.Nil(t, err)
+
+	err = Register[TestRegistryNumber]()
+	assert.Nil(t, err)
+
+	types := TypesInPackage("codnect.io/reflector")
+	assert.NotEmpty(t, types)
+
+	names := make([]string, 0)
+	for _, typ := range types {
+		assert.Equal(t, "codnect.io/reflector", typ.PackagePath())
+		names = append(names, typ.Name())
+	}
+
+	assert.Contains(t, names, "TestRegistryStruct1")
+	assert.Contains(t, names, "TestRegistryNumber")
+
+	types = TypesInPackage("codnect.io/unknown")
+	assert.Empty(t, types)
+
+	assert.GreaterOrEqual(t, len(Types()), 2)
+}
+
+func TestRegisterConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, Register[TestRegistryStruct1]())
+			_, exists := Lookup("codnect.io/reflector.TestRegistryStruct1")
+			assert.True(t, exists)
+			Types()
+		}()
+	}
+
+	wg.Wait()
+}
+
+type TestImplementationInterface interface {
+	Implemented()
+}
+
+type TestImplementationStruct1 struct {
+}
+
+func (t TestImplementationStruct1) Implemented() {
+}
+
+type TestImplementationStruct2 struct {
+}
+
+func (t *TestImplementationStruct2) Implemented() {
+}
+
+type TestImplementationStruct3 struct {
+}
+
+type TestImplementationNumber int
+
+func (t TestImplementationNumber) Implemented() {
+}
+
+func TestImplementations(t *testing.T) {
+	assert.Nil(t, Register[TestImplementationInterface]())
+	assert.Nil(t, Register[TestImplementationStruct1]())
+	assert.Nil(t, Register[TestImplementationStruct2]())
+	assert.Nil(t, Register[TestImplementationStruct3]())
+	assert.Nil(t, Register[TestImplementationNumber]())
+
+	iface := ToInterface(TypeOf[TestImplementationInterface]())
+	implementations := Implementations(iface)
+	assert.Len(t, implementations, 3)
+
+	implementor := implementations[0]
+	assert.Equal(t, "TestImplementationNumber", implementor.Type.Name())
+	assert.True(t, IsCustom(implementor.Type))
+	assert.Equal(t, ImplementationBoth, implementor.Kind)
+
+	implementor = implementations[1]
+	assert.Equal(t, "TestImplementationStruct1", implementor.Type.Name())
+	assert.True(t, IsStruct(implementor.Type))
+	assert.Equal(t, ImplementationBoth, implementor.Kind)
+
+	implementor = implementations[2]
+	assert.Equal(t, "TestImplementationStruct2", implementor.Type.Name())
+	assert.True(t, IsStruct(implementor.Type))
+	assert.Equal(t, ImplementationPointer, implementor.Kind)
+
+	implementations = Implementations(nil)
+	assert.Empty(t, implementations)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type Slice interface {
@@ -19,7 +20,8 @@ type Slice interface {
 }
 
 type sliceType struct {
-	elem Type
+	elemOnce sync.Once
+	elem     Type
 
 	parent       Type
 	reflectType  reflect.Type
@@ -29,7 +31,7 @@ type sliceType struct {
 func (s *sliceType) Name() string {
 	var builder strings.Builder
 	builder.WriteString("[]")
-	builder.WriteString(s.elem.Name())
+	builder.WriteString(s.elemType().Name())
 	return builder.String()
 }
 
@@ -82,6 +84,14 @@ func (s *sliceType) ReflectValue() *reflect.Value {
 	return s.reflectValue
 }
 
+func (s *sliceType) Kind() Kind {
+	return KindSlice
+}
+
+func (s *sliceType) UnderlyingKind() Kind {
+	return KindSlice
+}
+
 func (s *sliceType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -136,7 +146,7 @@ func (s *sliceType) Convert(typ Type) (Value, error) {
 }
 
 func (s *sliceType) Elem() Type {
-	return s.elem
+	return s.elemType()
 }
 
 func (s *sliceType) Len() (int, error) {
@@ -176,7 +186,7 @@ func (s *sliceType) Set(index int, val any) error {
 		return errors.New("array index out of range")
 	}
 
-	s.reflectValue.Index(index).Set(reflect.ValueOf(val))
+	s.reflectValue.Index(index).Set(valueOrZero(val, s.reflectType.Elem()))
 	return nil
 }
 
@@ -188,7 +198,7 @@ func (s *sliceType) Append(values ...any) (any, error) {
 	slice := *s.reflectValue
 
 	for _, value := range values {
-		slice = reflect.Append(slice, reflect.ValueOf(value))
+		slice = reflect.Append(slice, valueOrZero(value, slice.Type().Elem()))
 	}
 
 	return slice.Interface(), nil
@@ -221,3 +231,11 @@ func (s *sliceType) Copy(dst any) (int, error) {
 
 	return reflect.Copy(reflect.ValueOf(dst), *s.reflectValue), nil
 }
+
+func (s *sliceType) elemType() Type {
+	s.elemOnce.Do(func() {
+		s.elem = typeOf(nil, s.reflectType.Elem(), s.reflectValue, s)
+	})
+
+	return s.elem
+}
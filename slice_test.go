@@ -316,3 +316,33 @@ func TestTypeOfSliceObjectPointer(t *testing.T) {
 	assert.Empty(t, sliceVal)
 	assert.Equal(t, []int{}, sliceVal)
 }
+
+type TestList []TestList
+
+type TestListArray [2][]TestListArray
+
+func TestTypeOfSlice_RecursiveType(t *testing.T) {
+	list := TestList{TestList{}, nil}
+	typ := TypeOfAny(list)
+
+	assert.Equal(t, "TestList", typ.Name())
+
+	sliceType := ToSlice(ToCustom(typ).Underlying())
+	assert.NotNil(t, sliceType)
+	assert.Equal(t, "[]TestList", sliceType.Name())
+	assert.Equal(t, "TestList", sliceType.Elem().Name())
+
+	length, err := sliceType.Len()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, length)
+
+	arrayType := ToArray(ToCustom(TypeOf[TestListArray]()).Underlying())
+	assert.NotNil(t, arrayType)
+	assert.Equal(t, "[2][]TestListArray", arrayType.Name())
+	assert.Equal(t, "[]TestListArray", arrayType.Elem().Name())
+	assert.Equal(t, "TestListArray", ToSlice(arrayType.Elem()).Elem().Name())
+
+	chanType := ToChan(TypeOf[chan TestList]())
+	assert.Equal(t, "chan TestList", chanType.Name())
+	assert.Equal(t, "TestList", chanType.Elem().Name())
+}
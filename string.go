@@ -74,6 +74,14 @@ func (s *stringType) ReflectValue() *reflect.Value {
 	return s.reflectValue
 }
 
+func (s *stringType) Kind() Kind {
+	return KindString
+}
+
+func (s *stringType) UnderlyingKind() Kind {
+	return KindString
+}
+
 func (s *stringType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -3,7 +3,9 @@ package reflector
 import (
 	"errors"
 	"reflect"
+	"sort"
 	"strings"
+	"unicode"
 )
 
 type Struct interface {
@@ -11,18 +13,26 @@ type Struct interface {
 	Fields() []Field
 	Field(index int) (Field, bool)
 	FieldByName(name string) (Field, bool)
+	FieldByIndex(index []int) (Field, bool)
+	VisibleFields() []Field
+	VisibleFieldsByTag(key string) []Field
 	NumField() int
 	Methods() []Method
 	Method(index int) (Method, bool)
 	MethodByName(name string) (Method, bool)
 	NumMethod() int
+	ValueMethods() []Method
+	PointerMethods() []Method
 	Implements(i Interface) bool
+	ImplementsByValue(i Interface) bool
+	ImplementsByPointer(i Interface) bool
+	Implementation(i Interface) ImplementationKind
 	Embeds(another Type) bool
+	ValidateTags() []*TagError
 }
 
 type structType struct {
 	parent       Type
-	nilType      reflect.Type
 	reflectType  reflect.Type
 	reflectValue *reflect.Value
 }
@@ -87,6 +97,14 @@ func (s *structType) ReflectValue() *reflect.Value {
 	return s.reflectValue
 }
 
+func (s *structType) Kind() Kind {
+	return KindStruct
+}
+
+func (s *structType) UnderlyingKind() Kind {
+	return KindStruct
+}
+
 func (s *structType) Compare(another Type) bool {
 	if another == nil {
 		return false
@@ -142,17 +160,14 @@ func (s *structType) NumField() int {
 }
 
 func (s *structType) Fields() []Field {
-	fields := make([]Field, 0)
+	declaredFields := metadataOf(s.reflectType).declaredFields()
+	fields := make([]Field, len(declaredFields))
 
-	numField := s.reflectType.NumField()
-
-	for i := 0; i < numField; i++ {
-		structField := s.reflectType.Field(i)
-		fields = append(fields, &field{
-			index:       i,
-			structType:  s,
-			structField: structField,
-		})
+	for i, metadata := range declaredFields {
+		fields[i] = &field{
+			metadata:   metadata,
+			structType: s,
+		}
 	}
 
 	return fields
@@ -163,76 +178,112 @@ func (s *structType) Field(index int) (Field, bool) {
 		return nil, false
 	}
 
-	structField := s.reflectType.Field(index)
-
 	return &field{
-		index:       index,
-		structType:  s,
-		structField: structField,
+		metadata:   metadataOf(s.reflectType).declaredFields()[index],
+		structType: s,
 	}, true
 }
 
 func (s *structType) FieldByName(name string) (Field, bool) {
-	structField, exists := s.reflectType.FieldByName(name)
+	metadata, exists := metadataOf(s.reflectType).fieldByName(name)
 
 	if !exists {
 		return nil, false
 	}
 
 	return &field{
-		index:       structField.Index[0],
-		structType:  s,
-		structField: structField,
+		metadata:   metadata,
+		structType: s,
 	}, true
 }
 
-func (s *structType) Methods() []Method {
-	methods := make([]Method, 0)
-
-	typ := s.nilType
-	if s.Parent() != nil {
-		typ = s.nilType.Elem()
+func (s *structType) FieldByIndex(index []int) (Field, bool) {
+	if len(index) == 0 {
+		return nil, false
 	}
 
-	numMethod := s.NumMethod()
+	typ := s.reflectType
+
+	var structField reflect.StructField
+	for i, fieldIndex := range index {
+		if i > 0 {
+			if !structField.Anonymous {
+				return nil, false
+			}
+
+			typ = structField.Type
+			if typ.Kind() == reflect.Ptr {
+				typ = typ.Elem()
+			}
+		}
+
+		if typ.Kind() != reflect.Struct || fieldIndex < 0 || fieldIndex >= typ.NumField() {
+			return nil, false
+		}
 
-	for i := 0; i < numMethod; i++ {
-		method := typ.Method(i)
+		structField = typ.Field(fieldIndex)
+	}
 
-		methods = append(methods, &methodType{
-			parent:        s,
-			reflectMethod: method,
-		})
+	if len(index) == 1 {
+		return s.Field(index[0])
 	}
 
-	return methods
+	indexPath := make([]int, len(index))
+	copy(indexPath, index)
+
+	return &field{
+		metadata:   newFieldMetadata("", indexPath, structField),
+		structType: s,
+	}, true
 }
 
-func (s *structType) Method(index int) (Method, bool) {
-	if index < 0 || index >= s.NumMethod() {
-		return nil, false
+func (s *structType) VisibleFields() []Field {
+	return s.VisibleFieldsByTag("json")
+}
+
+func (s *structType) VisibleFieldsByTag(key string) []Field {
+	visibleFields := metadataOf(s.reflectType).visibleFieldsByTag(key)
+	fields := make([]Field, len(visibleFields))
+
+	for i, metadata := range visibleFields {
+		fields[i] = &field{
+			metadata:   metadata,
+			structType: s,
+		}
 	}
 
-	typ := s.nilType
-	if s.Parent() != nil {
-		typ = s.nilType.Elem()
+	return fields
+}
+
+func (s *structType) ValidateTags() []*TagError {
+	errs := make([]*TagError, 0)
+
+	for _, field := range s.Fields() {
+		errs = append(errs, field.ValidateTags()...)
 	}
 
-	method := typ.Method(index)
+	return errs
+}
+
+func (s *structType) Methods() []Method {
+	return methodsOf(s, s.methodSetType())
+}
+
+func (s *structType) Method(index int) (Method, bool) {
+	methodSet := metadataOf(s.methodSetType()).methodSet()
+
+	if index < 0 || index >= len(methodSet) {
+		return nil, false
+	}
 
 	return &methodType{
 		parent:        s,
-		reflectMethod: method,
+		reflectMethod: methodSet[index],
 	}, true
 }
 
 func (s *structType) MethodByName(name string) (Method, bool) {
-	typ := s.nilType
-	if s.Parent() != nil {
-		typ = s.nilType.Elem()
-	}
-
-	method, exists := typ.MethodByName(name)
+	method, exists := metadataOf(s.methodSetType()).methodByName(name)
 
 	if !exists {
 		return nil, false
@@ -245,23 +296,35 @@ func (s *structType) MethodByName(name string) (Method, bool) {
 }
 
 func (s *structType) NumMethod() int {
-	if s.Parent() != nil {
-		return s.nilType.Elem().NumMethod()
-	}
+	return s.methodSetType().NumMethod()
+}
+
+func (s *structType) ValueMethods() []Method {
+	return methodsOf(s, s.reflectType)
+}
 
-	return s.nilType.NumMethod()
+func (s *structType) PointerMethods() []Method {
+	return methodsOf(s, reflect.PtrTo(s.reflectType))
+}
+
+func (s *structType) methodSetType() reflect.Type {
+	return reflect.PtrTo(s.reflectType)
 }
 
 func (s *structType) Implements(i Interface) bool {
-	if i == nil {
-		return false
-	}
+	return implementsOf(s.Parent(), s.reflectType, i)
+}
 
-	if s.Parent() != nil {
-		return s.Parent().ReflectType().Implements(i.ReflectType())
-	}
+func (s *structType) ImplementsByValue(i Interface) bool {
+	return s.Implementation(i) == ImplementationBoth
+}
+
+func (s *structType) ImplementsByPointer(i Interface) bool {
+	return s.Implementation(i) != ImplementationNone
+}
 
-	return s.reflectType.Implements(i.ReflectType())
+func (s *structType) Implementation(i Interface) ImplementationKind {
+	return implementationOf(s.reflectType, i)
 }
 
 func (s *structType) Embeds(another Type) bool {
@@ -313,3 +376,179 @@ func (s *structType) embeds(candidate Type, visitedMap map[string]bool) bool {
 
 	return false
 }
+
+type visibleField struct {
+	name        string
+	tagged      bool
+	index       []int
+	typ         reflect.Type
+	structField reflect.StructField
+}
+
+func visibleFieldsOf(typ reflect.Type, key string) []visibleField {
+	current := make([]visibleField, 0)
+	next := []visibleField{{typ: typ}}
+
+	var count, nextCount map[reflect.Type]int
+	visited := make(map[reflect.Type]bool)
+
+	fields := make([]visibleField, 0)
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, make(map[reflect.Type]int)
+
+		for _, candidate := range current {
+			if visited[candidate.typ] {
+				continue
+			}
+
+			visited[candidate.typ] = true
+
+			for i := 0; i < candidate.typ.NumField(); i++ {
+				structField := candidate.typ.Field(i)
+
+				if structField.Anonymous {
+					fieldType := structField.Type
+					if fieldType.Kind() == reflect.Ptr {
+						fieldType = fieldType.Elem()
+					}
+
+					if !structField.IsExported() && fieldType.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !structField.IsExported() {
+					continue
+				}
+
+				name := ""
+				tags, _, _ := parseStructTag(structField.Name, string(structField.Tag))
+
+				if fieldTag, exists := tags.Find(key); exists {
+					if fieldTag.Value() == "-" {
+						continue
+					}
+
+					name = fieldTag.Primary()
+					if !isValidTagName(name) {
+						name = ""
+					}
+				}
+
+				index := make([]int, len(candidate.index)+1)
+				copy(index, candidate.index)
+				index[len(candidate.index)] = i
+
+				fieldType := structField.Type
+				if fieldType.Name() == "" && fieldType.Kind() == reflect.Ptr {
+					fieldType = fieldType.Elem()
+				}
+
+				if name != "" || !structField.Anonymous || fieldType.Kind() != reflect.Struct {
+					tagged := name != ""
+					if name == "" {
+						name = structField.Name
+					}
+
+					fields = append(fields, visibleField{
+						name:        name,
+						tagged:      tagged,
+						index:       index,
+						typ:         fieldType,
+						structField: structField,
+					})
+
+					if count[candidate.typ] > 1 {
+						fields = append(fields, fields[len(fields)-1])
+					}
+
+					continue
+				}
+
+				nextCount[fieldType]++
+				if nextCount[fieldType] == 1 {
+					next = append(next, visibleField{
+						name:  fieldType.Name(),
+						index: index,
+						typ:   fieldType,
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].name != fields[j].name {
+			return fields[i].name < fields[j].name
+		}
+
+		if len(fields[i].index) != len(fields[j].index) {
+			return len(fields[i].index) < len(fields[j].index)
+		}
+
+		if fields[i].tagged != fields[j].tagged {
+			return fields[i].tagged
+		}
+
+		return lessIndex(fields[i].index, fields[j].index)
+	})
+
+	dominantFields := make([]visibleField, 0, len(fields))
+
+	for advance, i := 0, 0; i < len(fields); i += advance {
+		candidate := fields[i]
+
+		for advance = 1; i+advance < len(fields); advance++ {
+			if fields[i+advance].name != candidate.name {
+				break
+			}
+		}
+
+		if advance == 1 {
+			dominantFields = append(dominantFields, candidate)
+			continue
+		}
+
+		if len(fields[i].index) == len(fields[i+1].index) && fields[i].tagged == fields[i+1].tagged {
+			continue
+		}
+
+		dominantFields = append(dominantFields, candidate)
+	}
+
+	sort.Slice(dominantFields, func(i, j int) bool {
+		return lessIndex(dominantFields[i].index, dominantFields[j].index)
+	})
+
+	return dominantFields
+}
+
+func lessIndex(x, y []int) bool {
+	for i, index := range x {
+		if i >= len(y) {
+			return false
+		}
+
+		if index != y[i] {
+			return index < y[i]
+		}
+	}
+
+	return len(x) < len(y)
+}
+
+func isValidTagName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, c := range name {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+
+	return true
+}
@@ -1233,3 +1233,132 @@ func TestTypeOfStructObjectPointer(t *testing.T) {
 	_, err = method.Invoke("anyValue")
 	assert.Nil(t, err)
 }
+
+type TestVisibleEmbeddedStruct1 struct {
+	Name    string
+	Shared  string `json:"shared"`
+	Ignored string `json:"-"`
+	Dash    string `json:"-,"`
+}
+
+type TestVisibleEmbeddedStruct2 struct {
+	Name   string
+	Shared string `json:"shared"`
+	Level  int
+}
+
+type testVisibleUnexportedStruct struct {
+	Promoted string
+}
+
+type TestVisibleStruct struct {
+	*TestVisibleEmbeddedStruct1
+	TestVisibleEmbeddedStruct2
+	testVisibleUnexportedStruct
+	Tagged   TestVisibleEmbeddedStruct2 `json:"tagged"`
+	Level    string                     `json:"level,omitempty"`
+	Invalid  string                     `json:"in\"valid"`
+	internal string
+}
+
+func TestStructVisibleFields(t *testing.T) {
+	structType := ToStruct(TypeOf[TestVisibleStruct]())
+
+	fields := structType.VisibleFields()
+	names := make([]string, 0)
+	for _, field := range fields {
+		names = append(names, field.VisibleName())
+	}
+
+	assert.Equal(t, []string{"-", "Level", "Promoted", "tagged", "level", "Invalid"}, names)
+
+	field := fields[0]
+	assert.Equal(t, "Dash", field.Name())
+	assert.Equal(t, []int{0, 3}, field.IndexPath())
+	assert.True(t, field.IsPromoted())
+
+	field = fields[1]
+	assert.Equal(t, "Level", field.Name())
+	assert.Equal(t, []int{1, 2}, field.IndexPath())
+	assert.True(t, field.IsPromoted())
+
+	field = fields[2]
+	assert.Equal(t, "Promoted", field.Name())
+	assert.Equal(t, []int{2, 0}, field.IndexPath())
+
+	field = fields[4]
+	assert.Equal(t, "Level", field.Name())
+	assert.Equal(t, []int{4}, field.IndexPath())
+	assert.False(t, field.IsPromoted())
+
+	field = fields[5]
+	assert.Equal(t, "Invalid", field.Name())
+	assert.Equal(t, "Invalid", field.VisibleName())
+
+	fields = structType.VisibleFieldsByTag("xml")
+	names = make([]string, 0)
+	for _, field := range fields {
+		names = append(names, field.VisibleName())
+	}
+
+	assert.Equal(t, []string{"Ignored", "Dash", "Promoted", "Tagged", "Level", "Invalid"}, names)
+}
+
+func TestStructVisibleFieldsValue(t *testing.T) {
+	val := &TestVisibleStruct{
+		TestVisibleEmbeddedStruct1: &TestVisibleEmbeddedStruct1{
+			Dash: "anyDashValue",
+		},
+	}
+
+	structType := ToStruct(ToPointer(TypeOfAny(val)).Elem())
+	fields := structType.VisibleFields()
+
+	fieldVal, err := fields[0].Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "anyDashValue", fieldVal)
+
+	err = fields[2].SetValue("anyPromotedValue")
+	assert.Nil(t, err)
+	assert.Equal(t, "anyPromotedValue", val.Promoted)
+}
+
+type TestRecursiveNode struct {
+	Name     string
+	Next     *TestRecursiveNode
+	Children []*TestRecursiveNode
+	Lookup   map[string]TestRecursiveNode
+}
+
+type TestRecursivePointer *TestRecursivePointer
+
+func TestTypeOfStruct_RecursiveType(t *testing.T) {
+	node := &TestRecursiveNode{Name: "root"}
+	node.Next = node
+
+	typ := TypeOfAny(node)
+	assert.Equal(t, "*TestRecursiveNode", typ.Name())
+
+	structType := ToStruct(ToPointer(typ).Elem())
+	assert.NotNil(t, structType)
+
+	next, exists := structType.FieldByName("Next")
+	assert.True(t, exists)
+	assert.Equal(t, "*TestRecursiveNode", next.Type().Name())
+
+	nextStruct := ToStruct(ToPointer(next.Type()).Elem())
+	assert.NotNil(t, nextStruct)
+	assert.Equal(t, "TestRecursiveNode", nextStruct.Name())
+
+	children, exists := structType.FieldByName("Children")
+	assert.True(t, exists)
+	assert.Equal(t, "[]*TestRecursiveNode", children.Type().Name())
+
+	lookup, exists := structType.FieldByName("Lookup")
+	assert.True(t, exists)
+	assert.Equal(t, "map[string]TestRecursiveNode", lookup.Type().Name())
+
+	pointerType := TypeOf[TestRecursivePointer]()
+	assert.Equal(t, "TestRecursivePointer", pointerType.Name())
+	assert.Equal(t, "TestRecursivePointer", ToPointer(ToCustom(pointerType).Underlying()).Elem().Name())
+}
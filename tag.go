@@ -1,5 +1,13 @@
 package reflector
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
 type Tags []Tag
 
 func (t Tags) Contains(name string) bool {
@@ -25,11 +33,32 @@ func (t Tags) Find(name string) (Tag, bool) {
 type Tag interface {
 	Name() string
 	Value() string
+	Primary() string
+	Options() []TagOption
+	Option(name string) (TagOption, bool)
+	HasOption(name string) bool
 }
 
 type tag struct {
-	name  string
-	value string
+	name    string
+	value   string
+	primary string
+	options []TagOption
+}
+
+func newTag(name, value string) *tag {
+	primary, options := tagParserOf(name)(value)
+
+	if options == nil {
+		options = make([]TagOption, 0)
+	}
+
+	return &tag{
+		name:    name,
+		value:   value,
+		primary: primary,
+		options: options,
+	}
 }
 
 func (t *tag) Name() string {
@@ -39,3 +68,262 @@ func (t *tag) Name() string {
 func (t *tag) Value() string {
 	return t.value
 }
+
+func (t *tag) Primary() string {
+	return t.primary
+}
+
+func (t *tag) Options() []TagOption {
+	options := make([]TagOption, len(t.options))
+	copy(options, t.options)
+	return options
+}
+
+func (t *tag) Option(name string) (TagOption, bool) {
+	for _, option := range t.options {
+		if option.Name() == name {
+			return option, true
+		}
+	}
+
+	return nil, false
+}
+
+func (t *tag) HasOption(name string) bool {
+	_, exists := t.Option(name)
+	return exists
+}
+
+type TagOption interface {
+	Name() string
+	Value() string
+	HasValue() bool
+}
+
+type tagOption struct {
+	name     string
+	value    string
+	hasValue bool
+}
+
+func NewTagOption(name string, value string) TagOption {
+	return &tagOption{
+		name:     name,
+		value:    value,
+		hasValue: value != "",
+	}
+}
+
+func (o *tagOption) Name() string {
+	return o.name
+}
+
+func (o *tagOption) Value() string {
+	return o.value
+}
+
+func (o *tagOption) HasValue() bool {
+	return o.hasValue
+}
+
+type TagParser func(value string) (primary string, options []TagOption)
+
+var (
+	tagParsersMu      sync.RWMutex
+	tagParsersVersion uint64
+	tagParsers        = map[string]TagParser{
+		"validate": OptionsTagParser,
+		"binding":  OptionsTagParser,
+	}
+)
+
+func RegisterTagParser(name string, parser TagParser) {
+	tagParsersMu.Lock()
+	defer tagParsersMu.Unlock()
+
+	atomic.AddUint64(&tagParsersVersion, 1)
+
+	if parser == nil {
+		delete(tagParsers, name)
+		return
+	}
+
+	tagParsers[name] = parser
+}
+
+func tagParserOf(name string) TagParser {
+	tagParsersMu.RLock()
+	defer tagParsersMu.RUnlock()
+
+	if parser, exists := tagParsers[name]; exists {
+		return parser
+	}
+
+	return DefaultTagParser
+}
+
+func DefaultTagParser(value string) (string, []TagOption) {
+	primary := value
+	rest := ""
+
+	if commaIndex := strings.Index(value, ","); commaIndex != -1 {
+		primary = value[:commaIndex]
+		rest = value[commaIndex+1:]
+	}
+
+	return primary, parseTagOptions(rest)
+}
+
+func OptionsTagParser(value string) (string, []TagOption) {
+	return "", parseTagOptions(value)
+}
+
+func parseTagOptions(value string) []TagOption {
+	options := make([]TagOption, 0)
+
+	for value != "" {
+		option := value
+		commaIndex := strings.Index(value, ",")
+
+		if commaIndex != -1 {
+			option, value = value[:commaIndex], value[commaIndex+1:]
+		} else {
+			value = ""
+		}
+
+		if option == "" {
+			continue
+		}
+
+		if equalIndex := strings.Index(option, "="); equalIndex != -1 {
+			options = append(options, &tagOption{
+				name:     option[:equalIndex],
+				value:    option[equalIndex+1:],
+				hasValue: true,
+			})
+			continue
+		}
+
+		options = append(options, &tagOption{
+			name: option,
+		})
+	}
+
+	return options
+}
+
+type TagError struct {
+	Field  string
+	Key    string
+	Offset int
+	Reason string
+}
+
+func (e *TagError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("field '%s': malformed tag at offset %d: %s", e.Field, e.Offset, e.Reason)
+	}
+
+	return fmt.Sprintf("field '%s': tag '%s' at offset %d: %s", e.Field, e.Key, e.Offset, e.Reason)
+}
+
+func parseStructTag(fieldName string, raw string) (Tags, []*TagError, int) {
+	tags := make(Tags, 0)
+	errs := make([]*TagError, 0)
+	keys := make(map[string]struct{})
+	valid := -1
+
+	fail := func(key string, offset int, reason string) {
+		if valid == -1 {
+			valid = len(tags)
+		}
+
+		errs = append(errs, &TagError{
+			Field:  fieldName,
+			Key:    key,
+			Offset: offset,
+			Reason: reason,
+		})
+	}
+
+	skip := func(offset int) int {
+		for offset < len(raw) && raw[offset] != ' ' {
+			offset++
+		}
+
+		return offset
+	}
+
+	offset := 0
+
+	for offset < len(raw) {
+		for offset < len(raw) && raw[offset] == ' ' {
+			offset++
+		}
+
+		if offset >= len(raw) {
+			break
+		}
+
+		start := offset
+		i := offset
+		for i < len(raw) && raw[i] > ' ' && raw[i] != ':' && raw[i] != '"' && raw[i] != 0x7f {
+			i++
+		}
+
+		if i == start {
+			fail("", start, "missing key")
+			offset = skip(i + 1)
+			continue
+		}
+
+		key := raw[start:i]
+
+		if i >= len(raw) || raw[i] != ':' {
+			fail(key, i, "missing ':' after key")
+			offset = skip(i)
+			continue
+		}
+
+		if i+1 >= len(raw) || raw[i+1] != '"' {
+			fail(key, i+1, "value is not quoted")
+			offset = skip(i + 1)
+			continue
+		}
+
+		j := i + 2
+		for j < len(raw) && raw[j] != '"' {
+			if raw[j] == '\\' {
+				j++
+			}
+			j++
+		}
+
+		if j >= len(raw) {
+			fail(key, i+1, "unterminated quoted value")
+			break
+		}
+
+		value, err := strconv.Unquote(raw[i+1 : j+1])
+		offset = j + 1
+
+		if err != nil {
+			fail(key, i+1, "invalid quoted value")
+			continue
+		}
+
+		if _, exists := keys[key]; exists {
+			errs = append(errs, &TagError{
+				Field:  fieldName,
+				Key:    key,
+				Offset: start,
+				Reason: "duplicate key",
+			})
+		}
+
+		keys[key] = struct{}{}
+		tags = append(tags, newTag(key, value))
+	}
+
+	return tags, errs, valid
+}
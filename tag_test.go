@@ -0,0 +1,171 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type TestTagStruct struct {
+	UserName string `json:"user_name,omitempty,string" db:"user_name" validate:"min=1,max=10"`
+	Age      int    `json:",omitempty" yaml:"age" custom:"age|required"`
+	Ignored  bool   `json:"-"`
+}
+
+func TestTagOptions(t *testing.T) {
+	structType := ToStruct(TypeOf[TestTagStruct]())
+	fields := structType.Fields()
+
+	tags := fields[0].Tags()
+	assert.Len(t, tags, 3)
+
+	tag, exists := tags.Find("json")
+	assert.True(t, exists)
+	assert.Equal(t, "user_name,omitempty,string", tag.Value())
+	assert.Equal(t, "user_name", tag.Primary())
+	assert.Len(t, tag.Options(), 2)
+	assert.True(t, tag.HasOption("omitempty"))
+	assert.True(t, tag.HasOption("string"))
+	assert.False(t, tag.HasOption("user_name"))
+
+	option, exists := tag.Option("omitempty")
+	assert.True(t, exists)
+	assert.Equal(t, "omitempty", option.Name())
+	assert.Equal(t, "", option.Value())
+	assert.False(t, option.HasValue())
+
+	tag, exists = tags.Find("db")
+	assert.True(t, exists)
+	assert.Equal(t, "user_name", tag.Primary())
+	assert.Empty(t, tag.Options())
+
+	tag, exists = tags.Find("validate")
+	assert.True(t, exists)
+	assert.Equal(t, "", tag.Primary())
+	assert.Len(t, tag.Options(), 2)
+
+	option, exists = tag.Option("min")
+	assert.True(t, exists)
+	assert.Equal(t, "1", option.Value())
+	assert.True(t, option.HasValue())
+
+	option, exists = tag.Option("max")
+	assert.True(t, exists)
+	assert.Equal(t, "10", option.Value())
+
+	option, exists = tag.Option("len")
+	assert.False(t, exists)
+	assert.Nil(t, option)
+
+	tags = fields[1].Tags()
+	tag, exists = tags.Find("json")
+	assert.True(t, exists)
+	assert.Equal(t, "", tag.Primary())
+	assert.True(t, tag.HasOption("omitempty"))
+
+	tags = fields[2].Tags()
+	tag, exists = tags.Find("json")
+	assert.True(t, exists)
+	assert.Equal(t, "-", tag.Primary())
+	assert.Empty(t, tag.Options())
+}
+
+func TestRegisterTagParser(t *testing.T) {
+	RegisterTagParser("custom", func(value string) (string, []TagOption) {
+		parts := strings.Split(value, "|")
+		options := make([]TagOption, 0)
+
+		for _, part := range parts[1:] {
+			options = append(options, NewTagOption(part, ""))
+		}
+
+		return parts[0], options
+	})
+	defer RegisterTagParser("custom", nil)
+
+	structType := ToStruct(TypeOf[TestTagStruct]())
+	field, exists := structType.FieldByName("Age")
+	assert.True(t, exists)
+
+	tag, exists := field.Tags().Find("custom")
+	assert.True(t, exists)
+	assert.Equal(t, "age", tag.Primary())
+	assert.True(t, tag.HasOption("required"))
+
+	RegisterTagParser("custom", nil)
+
+	tag, exists = field.Tags().Find("custom")
+	assert.True(t, exists)
+	assert.Equal(t, "age|required", tag.Primary())
+	assert.False(t, tag.HasOption("required"))
+}
+
+func TestValidateTags(t *testing.T) {
+	reflectType := reflect.StructOf([]reflect.StructField{
+		{
+			Name: "Valid",
+			Type: reflect.TypeOf(""),
+			Tag:  `json:"valid,omitempty" db:"valid"`,
+		},
+		{
+			Name: "Unquoted",
+			Type: reflect.TypeOf(""),
+			Tag:  `json:user" db:"user_name"`,
+		},
+		{
+			Name: "Duplicate",
+			Type: reflect.TypeOf(0),
+			Tag:  `json:"a" json:"b"`,
+		},
+		{
+			Name: "MissingColon",
+			Type: reflect.TypeOf(0),
+			Tag:  `json"a" :"b" db:"c`,
+		},
+	})
+
+	structType := ToStruct(typeOf(reflect.PtrTo(reflectType), reflectType, nil, nil))
+	assert.NotNil(t, structType)
+
+	field, _ := structType.Field(0)
+	assert.Empty(t, field.ValidateTags())
+	assert.Len(t, field.Tags(), 2)
+
+	field, _ = structType.Field(1)
+	errs := field.ValidateTags()
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Unquoted", errs[0].Field)
+	assert.Equal(t, "json", errs[0].Key)
+	assert.Equal(t, 5, errs[0].Offset)
+	assert.Equal(t, "value is not quoted", errs[0].Reason)
+	assert.Equal(t, "field 'Unquoted': tag 'json' at offset 5: value is not quoted", errs[0].Error())
+	assert.Empty(t, field.Tags())
+
+	field, _ = structType.Field(2)
+	errs = field.ValidateTags()
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "json", errs[0].Key)
+	assert.Equal(t, 9, errs[0].Offset)
+	assert.Equal(t, "duplicate key", errs[0].Reason)
+	assert.Len(t, field.Tags(), 2)
+
+	field, _ = structType.Field(3)
+	errs = field.ValidateTags()
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "json", errs[0].Key)
+	assert.Equal(t, 4, errs[0].Offset)
+	assert.Equal(t, "missing ':' after key", errs[0].Reason)
+	assert.Equal(t, "", errs[1].Key)
+	assert.Equal(t, 8, errs[1].Offset)
+	assert.Equal(t, "missing key", errs[1].Reason)
+	assert.Equal(t, "field 'MissingColon': malformed tag at offset 8: missing key", errs[1].Error())
+	assert.Equal(t, "db", errs[2].Key)
+	assert.Equal(t, 16, errs[2].Offset)
+	assert.Equal(t, "unterminated quoted value", errs[2].Reason)
+
+	errs = structType.ValidateTags()
+	assert.Len(t, errs, 5)
+
+	assert.Empty(t, ToStruct(TypeOf[TestTagStruct]()).ValidateTags())
+}
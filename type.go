@@ -15,6 +15,8 @@ type Type interface {
 	Parent() Type
 	ReflectType() reflect.Type
 	ReflectValue() *reflect.Value
+	Kind() Kind
+	UnderlyingKind() Kind
 	Compare(another Type) bool
 	IsInstantiable() bool
 	Instantiate() (Value, error)
@@ -35,6 +37,7 @@ var builtinTypes = map[string]struct{}{
 	"uint16":     {},
 	"uint32":     {},
 	"uint64":     {},
+	"uintptr":    {},
 	"float32":    {},
 	"float64":    {},
 	"complex64":  {},
@@ -43,7 +46,7 @@ var builtinTypes = map[string]struct{}{
 
 func TypeOf[T any]() Type {
 	typ := reflect.TypeOf((*T)(nil))
-	return typeOf(typ, typ.Elem(), nil, nil)
+	return unboundTypeOf(typ.Elem())
 }
 
 func TypeOfAny[T any](obj T) Type {
@@ -62,23 +65,13 @@ func typeOf(nilType reflect.Type, typ reflect.Type, val *reflect.Value, parent T
 	switch typ.Kind() {
 	case reflect.Ptr:
 		ptr := &pointer{
+			parent:       parent,
+			nilType:      nilType,
 			reflectType:  typ,
 			reflectValue: val,
 		}
 
-		if parent != nil && val != nil {
-			ptr.base = typeOf(nilType, typ.Elem(), val, ptr)
-		} else if val != nil {
-			elem := val.Elem()
-			ptr.base = typeOf(nilType, typ.Elem(), &elem, ptr)
-		} else {
-			ptr.base = typeOf(nilType, typ.Elem(), nil, ptr)
-		}
-
-		baseTypeName := ptr.base.Name()
-		pointerTypeName := ptr.reflectType.Name()
-
-		if pointerTypeName != "" && pointerTypeName != baseTypeName {
+		if typ.Name() != "" {
 			return getCustomType(typ, val, parent, ptr)
 		}
 
@@ -86,7 +79,6 @@ func typeOf(nilType reflect.Type, typ reflect.Type, val *reflect.Value, parent T
 	case reflect.Struct:
 		structType := &structType{
 			parent:       parent,
-			nilType:      nilType,
 			reflectType:  typ,
 			reflectValue: val,
 		}
@@ -113,9 +105,6 @@ func typeOf(nilType reflect.Type, typ reflect.Type, val *reflect.Value, parent T
 			reflectValue: val,
 		}
 
-		mapType.key = typeOf(nil, typ.Key(), val, mapType)
-		mapType.elem = typeOf(nil, typ.Elem(), val, mapType)
-
 		if typ.Name() != "" {
 			return getCustomType(typ, val, parent, mapType)
 		}
@@ -128,8 +117,6 @@ func typeOf(nilType reflect.Type, typ reflect.Type, val *reflect.Value, parent T
 			reflectValue: val,
 		}
 
-		arrayType.elem = typeOf(nil, typ.Elem(), val, arrayType)
-
 		if typ.Name() != "" {
 			return getCustomType(typ, val, parent, arrayType)
 		}
@@ -142,8 +129,6 @@ func typeOf(nilType reflect.Type, typ reflect.Type, val *reflect.Value, parent T
 			reflectValue: val,
 		}
 
-		sliceType.elem = typeOf(nil, typ.Elem(), val, sliceType)
-
 		if typ.Name() != "" {
 			return getCustomType(typ, val, parent, sliceType)
 		}
@@ -168,8 +153,6 @@ func typeOf(nilType reflect.Type, typ reflect.Type, val *reflect.Value, parent T
 			reflectValue: val,
 		}
 
-		chanType.elem = typeOf(nil, typ.Elem(), val, chanType)
-
 		if typ.Name() != "" {
 			return getCustomType(typ, val, parent, chanType)
 		}
@@ -246,6 +229,30 @@ func typeOf(nilType reflect.Type, typ reflect.Type, val *reflect.Value, parent T
 		}
 
 		return complexType
+	case reflect.Uintptr:
+		uintptrType := &uintptrType{
+			parent:       parent,
+			reflectType:  typ,
+			reflectValue: val,
+		}
+
+		if _, exists := builtinTypes[typ.Name()]; !exists {
+			return getCustomType(typ, val, parent, uintptrType)
+		}
+
+		return uintptrType
+	case reflect.UnsafePointer:
+		pointerType := &unsafePointerType{
+			parent:       parent,
+			reflectType:  typ,
+			reflectValue: val,
+		}
+
+		if typ != unsafePointerReflectType {
+			return getCustomType(typ, val, parent, pointerType)
+		}
+
+		return pointerType
 	default:
 		return nil
 	}
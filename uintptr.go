@@ -0,0 +1,150 @@
+package reflector
+
+import (
+	"errors"
+	"reflect"
+)
+
+type Uintptr interface {
+	Type
+	UintptrValue() (uintptr, error)
+	SetUintptrValue(val uintptr) error
+}
+
+type uintptrType struct {
+	parent       Type
+	reflectType  reflect.Type
+	reflectValue *reflect.Value
+}
+
+func (u *uintptrType) Name() string {
+	return u.reflectType.Name()
+}
+
+func (u *uintptrType) PackageName() string {
+	return ""
+}
+
+func (u *uintptrType) PackagePath() string {
+	return ""
+}
+
+func (u *uintptrType) CanSet() bool {
+	if u.reflectValue == nil {
+		return false
+	}
+
+	return u.reflectValue.CanSet()
+}
+
+func (u *uintptrType) HasValue() bool {
+	return u.reflectValue != nil
+}
+
+func (u *uintptrType) Value() (any, error) {
+	if u.reflectValue == nil {
+		return nil, errors.New("value reference is nil")
+	}
+
+	return u.reflectValue.Interface(), nil
+}
+
+func (u *uintptrType) SetValue(val any) error {
+	if !u.CanSet() {
+		return errors.New("value cannot be set")
+	}
+
+	switch typedVal := val.(type) {
+	case uintptr:
+		return u.SetUintptrValue(typedVal)
+	default:
+		return errors.New("type is not valid")
+	}
+}
+
+func (u *uintptrType) Parent() Type {
+	return u.parent
+}
+
+func (u *uintptrType) ReflectType() reflect.Type {
+	return u.reflectType
+}
+
+func (u *uintptrType) ReflectValue() *reflect.Value {
+	return u.reflectValue
+}
+
+func (u *uintptrType) Kind() Kind {
+	return KindUintptr
+}
+
+func (u *uintptrType) UnderlyingKind() Kind {
+	return KindUintptr
+}
+
+func (u *uintptrType) Compare(another Type) bool {
+	if another == nil {
+		return false
+	}
+
+	return u.reflectType == another.ReflectType()
+}
+
+func (u *uintptrType) IsInstantiable() bool {
+	return true
+}
+
+func (u *uintptrType) Instantiate() (Value, error) {
+	return &value{
+		reflect.New(u.reflectType),
+	}, nil
+}
+
+func (u *uintptrType) CanConvert(typ Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	if u.reflectValue == nil {
+		return u.reflectType.ConvertibleTo(typ.ReflectType())
+	}
+
+	return u.reflectValue.CanConvert(typ.ReflectType())
+}
+
+func (u *uintptrType) Convert(typ Type) (Value, error) {
+	if typ == nil {
+		return nil, errors.New("typ should not be nil")
+	}
+
+	if u.reflectValue == nil {
+		return nil, errors.New("value reference is nil")
+	}
+
+	if !u.CanConvert(typ) {
+		return nil, errors.New("type is not valid")
+	}
+
+	val := u.reflectValue.Convert(typ.ReflectType())
+
+	return &value{
+		val,
+	}, nil
+}
+
+func (u *uintptrType) UintptrValue() (uintptr, error) {
+	if u.reflectValue == nil {
+		return 0, errors.New("value reference is nil")
+	}
+
+	return uintptr(u.reflectValue.Uint()), nil
+}
+
+func (u *uintptrType) SetUintptrValue(val uintptr) error {
+	if !u.CanSet() {
+		return errors.New("value cannot be set")
+	}
+
+	u.reflectValue.SetUint(uint64(val))
+	return nil
+}
@@ -0,0 +1,84 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestUintptr uintptr
+
+type TestUintptrStruct struct {
+	Address uintptr
+	Named   TestUintptr
+}
+
+func TestTypeOfUintptr(t *testing.T) {
+	typ := TypeOf[uintptr]()
+	assert.True(t, IsUintptr(typ))
+	assert.False(t, IsCustom(typ))
+	assert.Equal(t, "uintptr", typ.Name())
+	assert.Equal(t, "", typ.PackageName())
+
+	assert.False(t, typ.HasValue())
+	assert.NotNil(t, typ.ReflectType())
+	assert.Nil(t, typ.ReflectValue())
+
+	uintptrType := ToUintptr(typ)
+	assert.NotNil(t, uintptrType)
+
+	assert.False(t, uintptrType.CanSet())
+	assert.True(t, uintptrType.IsInstantiable())
+	assert.True(t, uintptrType.Compare(TypeOf[uintptr]()))
+	assert.False(t, uintptrType.Compare(TypeOf[uint]()))
+
+	_, err := uintptrType.Value()
+	assert.NotNil(t, err)
+
+	_, err = uintptrType.UintptrValue()
+	assert.NotNil(t, err)
+
+	assert.NotNil(t, uintptrType.SetUintptrValue(1))
+	assert.True(t, uintptrType.CanConvert(TypeOf[uint64]()))
+
+	instance, err := uintptrType.Instantiate()
+	assert.Nil(t, err)
+	assert.Equal(t, uintptr(0), instance.Elem())
+}
+
+func TestTypeOfUintptrObject(t *testing.T) {
+	address := uintptr(42)
+	typ := ToUintptr(ToPointer(TypeOfAny(&address)).Elem())
+	assert.NotNil(t, typ)
+
+	val, err := typ.UintptrValue()
+	assert.Nil(t, err)
+	assert.Equal(t, uintptr(42), val)
+
+	assert.Nil(t, typ.SetUintptrValue(64))
+	assert.Equal(t, uintptr(64), address)
+
+	assert.Nil(t, typ.SetValue(uintptr(128)))
+	assert.Equal(t, uintptr(128), address)
+	assert.NotNil(t, typ.SetValue(128))
+
+	converted, err := typ.Convert(TypeOf[uint64]())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(128), converted.Val())
+
+	assert.Nil(t, ToUintptr(TypeOfAny(uint(1))))
+	assert.True(t, IsUintptr(TypeOfAny(uintptr(1))))
+}
+
+func TestTypeOfUintptrField(t *testing.T) {
+	obj := &TestUintptrStruct{Address: 7, Named: 8}
+	structType := ToStruct(ToPointer(TypeOfAny(obj)).Elem())
+
+	address, exists := structType.FieldByName("Address")
+	assert.True(t, exists)
+	assert.True(t, IsUintptr(address.Type()))
+
+	named, exists := structType.FieldByName("Named")
+	assert.True(t, exists)
+	assert.True(t, IsCustom(named.Type()))
+	assert.Equal(t, "TestUintptr", named.Type().Name())
+}
@@ -0,0 +1,175 @@
+package reflector
+
+import (
+	"errors"
+	"reflect"
+	"unsafe"
+)
+
+var unsafePointerReflectType = reflect.TypeOf(unsafe.Pointer(nil))
+
+type UnsafePointer interface {
+	Type
+	PointerValue() (unsafe.Pointer, error)
+	SetPointerValue(val unsafe.Pointer) error
+	UintptrValue() (uintptr, error)
+}
+
+type unsafePointerType struct {
+	parent       Type
+	reflectType  reflect.Type
+	reflectValue *reflect.Value
+}
+
+func (u *unsafePointerType) Name() string {
+	return u.reflectType.Name()
+}
+
+func (u *unsafePointerType) PackageName() string {
+	return "unsafe"
+}
+
+func (u *unsafePointerType) PackagePath() string {
+	return "unsafe"
+}
+
+func (u *unsafePointerType) CanSet() bool {
+	if u.reflectValue == nil {
+		return false
+	}
+
+	return u.reflectValue.CanSet()
+}
+
+func (u *unsafePointerType) HasValue() bool {
+	return u.reflectValue != nil
+}
+
+func (u *unsafePointerType) Value() (any, error) {
+	if u.reflectValue == nil {
+		return nil, errors.New("value reference is nil")
+	}
+
+	return u.reflectValue.Interface(), nil
+}
+
+func (u *unsafePointerType) SetValue(val any) error {
+	if !u.CanSet() {
+		return errors.New("value cannot be set")
+	}
+
+	switch typedVal := val.(type) {
+	case unsafe.Pointer:
+		return u.SetPointerValue(typedVal)
+	default:
+		return errors.New("type is not valid")
+	}
+}
+
+func (u *unsafePointerType) Parent() Type {
+	return u.parent
+}
+
+func (u *unsafePointerType) ReflectType() reflect.Type {
+	return u.reflectType
+}
+
+func (u *unsafePointerType) ReflectValue() *reflect.Value {
+	return u.reflectValue
+}
+
+func (u *unsafePointerType) Kind() Kind {
+	return KindUnsafePointer
+}
+
+func (u *unsafePointerType) UnderlyingKind() Kind {
+	return KindUnsafePointer
+}
+
+func (u *unsafePointerType) Compare(another Type) bool {
+	if another == nil {
+		return false
+	}
+
+	return u.reflectType == another.ReflectType()
+}
+
+func (u *unsafePointerType) IsInstantiable() bool {
+	return true
+}
+
+func (u *unsafePointerType) Instantiate() (Value, error) {
+	return &value{
+		reflect.New(u.reflectType),
+	}, nil
+}
+
+func (u *unsafePointerType) CanConvert(typ Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	switch typ.ReflectType().Kind() {
+	case reflect.Ptr, reflect.Uintptr, reflect.UnsafePointer:
+		return true
+	default:
+		return false
+	}
+}
+
+func (u *unsafePointerType) Convert(typ Type) (Value, error) {
+	if typ == nil {
+		return nil, errors.New("typ should not be nil")
+	}
+
+	if u.reflectValue == nil {
+		return nil, errors.New("value reference is nil")
+	}
+
+	if !u.CanConvert(typ) {
+		return nil, errors.New("type is not valid")
+	}
+
+	target := typ.ReflectType()
+	ptr := u.reflectValue.UnsafePointer()
+
+	switch target.Kind() {
+	case reflect.Ptr:
+		return &value{
+			reflect.NewAt(target.Elem(), ptr),
+		}, nil
+	case reflect.Uintptr:
+		return &value{
+			reflect.ValueOf(uintptr(ptr)).Convert(target),
+		}, nil
+	default:
+		return &value{
+			reflect.ValueOf(ptr).Convert(target),
+		}, nil
+	}
+}
+
+func (u *unsafePointerType) PointerValue() (unsafe.Pointer, error) {
+	if u.reflectValue == nil {
+		return nil, errors.New("value reference is nil")
+	}
+
+	return u.reflectValue.UnsafePointer(), nil
+}
+
+func (u *unsafePointerType) SetPointerValue(val unsafe.Pointer) error {
+	if !u.CanSet() {
+		return errors.New("value cannot be set")
+	}
+
+	u.reflectValue.SetPointer(val)
+	return nil
+}
+
+func (u *unsafePointerType) UintptrValue() (uintptr, error) {
+	if u.reflectValue == nil {
+		return 0, errors.New("value reference is nil")
+	}
+
+	return u.reflectValue.Pointer(), nil
+}
@@ -0,0 +1,89 @@
+package reflector
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"unsafe"
+)
+
+type TestUnsafePointer unsafe.Pointer
+
+type TestUnsafePointerStruct struct {
+	Pointer unsafe.Pointer
+	Named   TestUnsafePointer
+}
+
+func TestTypeOfUnsafePointer(t *testing.T) {
+	typ := TypeOf[unsafe.Pointer]()
+	assert.True(t, IsUnsafePointer(typ))
+	assert.False(t, IsPointer(typ))
+	assert.Equal(t, "Pointer", typ.Name())
+	assert.Equal(t, "unsafe", typ.PackageName())
+	assert.Equal(t, "unsafe", typ.PackagePath())
+
+	pointerType := ToUnsafePointer(typ)
+	assert.NotNil(t, pointerType)
+
+	assert.False(t, pointerType.CanSet())
+	assert.True(t, pointerType.IsInstantiable())
+	assert.True(t, pointerType.Compare(TypeOf[unsafe.Pointer]()))
+	assert.False(t, pointerType.Compare(TypeOf[uintptr]()))
+
+	_, err := pointerType.PointerValue()
+	assert.NotNil(t, err)
+
+	_, err = pointerType.UintptrValue()
+	assert.NotNil(t, err)
+
+	assert.NotNil(t, pointerType.SetPointerValue(nil))
+	assert.True(t, pointerType.CanConvert(TypeOf[*int]()))
+}
+
+func TestTypeOfUnsafePointerObject(t *testing.T) {
+	number := 42
+	ptr := unsafe.Pointer(&number)
+
+	typ := ToUnsafePointer(ToPointer(TypeOfAny(&ptr)).Elem())
+	assert.NotNil(t, typ)
+
+	val, err := typ.PointerValue()
+	assert.Nil(t, err)
+	assert.Equal(t, unsafe.Pointer(&number), val)
+
+	address, err := typ.UintptrValue()
+	assert.Nil(t, err)
+	assert.Equal(t, uintptr(unsafe.Pointer(&number)), address)
+
+	converted, err := typ.Convert(TypeOf[*int]())
+	assert.Nil(t, err)
+	assert.Equal(t, 42, *converted.Val().(*int))
+
+	converted, err = typ.Convert(TypeOf[uintptr]())
+	assert.Nil(t, err)
+	assert.Equal(t, address, converted.Val())
+
+	_, err = typ.Convert(TypeOf[string]())
+	assert.NotNil(t, err)
+
+	other := 7
+	assert.Nil(t, typ.SetPointerValue(unsafe.Pointer(&other)))
+	assert.Equal(t, unsafe.Pointer(&other), ptr)
+
+	assert.Nil(t, typ.SetValue(unsafe.Pointer(&number)))
+	assert.Equal(t, unsafe.Pointer(&number), ptr)
+	assert.NotNil(t, typ.SetValue(&number))
+}
+
+func TestTypeOfUnsafePointerField(t *testing.T) {
+	obj := &TestUnsafePointerStruct{}
+	structType := ToStruct(ToPointer(TypeOfAny(obj)).Elem())
+
+	pointer, exists := structType.FieldByName("Pointer")
+	assert.True(t, exists)
+	assert.True(t, IsUnsafePointer(pointer.Type()))
+
+	named, exists := structType.FieldByName("Named")
+	assert.True(t, exists)
+	assert.True(t, IsCustom(named.Type()))
+	assert.Equal(t, "TestUnsafePointer", named.Type().Name())
+}
@@ -0,0 +1,233 @@
+package reflector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+type WalkAction int
+
+const (
+	WalkContinue WalkAction = iota
+	WalkSkip
+	WalkStop
+)
+
+type Node interface {
+	Path() string
+	Depth() int
+	Type() Type
+	Value() (any, error)
+	HasValue() bool
+	IsCycle() bool
+	ReflectType() reflect.Type
+	ReflectValue() reflect.Value
+}
+
+type node struct {
+	path         string
+	depth        int
+	cycle        bool
+	reflectType  reflect.Type
+	reflectValue reflect.Value
+}
+
+func (n *node) Path() string {
+	return n.path
+}
+
+func (n *node) Depth() int {
+	return n.depth
+}
+
+func (n *node) Type() Type {
+	if !n.reflectValue.IsValid() || !n.reflectValue.CanInterface() {
+		return typeOf(reflect.PtrTo(n.reflectType), n.reflectType, nil, nil)
+	}
+
+	val := n.reflectValue
+	return typeOf(reflect.PtrTo(n.reflectType), n.reflectType, &val, nil)
+}
+
+func (n *node) Value() (any, error) {
+	if !n.reflectValue.IsValid() {
+		return nil, errors.New("value reference is nil")
+	}
+
+	if !n.reflectValue.CanInterface() {
+		return nil, errors.New("value is unexported")
+	}
+
+	return n.reflectValue.Interface(), nil
+}
+
+func (n *node) HasValue() bool {
+	return n.reflectValue.IsValid()
+}
+
+func (n *node) IsCycle() bool {
+	return n.cycle
+}
+
+func (n *node) ReflectType() reflect.Type {
+	return n.reflectType
+}
+
+func (n *node) ReflectValue() reflect.Value {
+	return n.reflectValue
+}
+
+type Visitor interface {
+	Enter(node Node) WalkAction
+	Leave(node Node) WalkAction
+}
+
+type WalkFunc func(node Node) WalkAction
+
+func (f WalkFunc) Enter(node Node) WalkAction {
+	return f(node)
+}
+
+func (f WalkFunc) Leave(node Node) WalkAction {
+	return WalkContinue
+}
+
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+type walker struct {
+	visitor Visitor
+	visited map[visit]bool
+}
+
+func Walk(obj any, visitor Visitor) error {
+	if visitor == nil {
+		return errors.New("visitor should not be nil")
+	}
+
+	val := reflect.ValueOf(obj)
+
+	if !val.IsValid() {
+		return errors.New("obj should not be nil")
+	}
+
+	w := &walker{
+		visitor: visitor,
+		visited: make(map[visit]bool),
+	}
+
+	w.walk(val, val.Type(), "", 0)
+	return nil
+}
+
+func (w *walker) walk(val reflect.Value, typ reflect.Type, path string, depth int) bool {
+	current := &node{
+		path:         path,
+		depth:        depth,
+		reflectType:  typ,
+		reflectValue: val,
+	}
+
+	key, tracked := visitOf(val)
+	if tracked && w.visited[key] {
+		current.cycle = true
+	}
+
+	action := w.visitor.Enter(current)
+	if action == WalkStop {
+		return false
+	}
+
+	if action != WalkSkip && !current.cycle {
+		if tracked {
+			w.visited[key] = true
+		}
+
+		next := w.walkChildren(val, typ, path, depth+1)
+
+		if tracked {
+			delete(w.visited, key)
+		}
+
+		if !next {
+			return false
+		}
+	}
+
+	return w.visitor.Leave(current) != WalkStop
+}
+
+func (w *walker) walkChildren(val reflect.Value, typ reflect.Type, path string, depth int) bool {
+	if !val.IsValid() {
+		return true
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if val.IsNil() {
+			return true
+		}
+
+		elem := val.Elem()
+		return w.walk(elem, elem.Type(), path, depth)
+	case reflect.Struct:
+		numField := val.NumField()
+
+		for i := 0; i < numField; i++ {
+			structField := typ.Field(i)
+
+			if !w.walk(val.Field(i), structField.Type, joinPath(path, structField.Name), depth) {
+				return false
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		length := val.Len()
+
+		for i := 0; i < length; i++ {
+			if !w.walk(val.Index(i), typ.Elem(), path+"["+strconv.Itoa(i)+"]", depth) {
+				return false
+			}
+		}
+	case reflect.Map:
+		keys := val.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+
+		for _, key := range keys {
+			if !w.walk(val.MapIndex(key), typ.Elem(), path+"["+formatKey(key)+"]", depth) {
+				return false
+			}
+		}
+	case reflect.Chan:
+		return w.walk(reflect.Value{}, typ.Elem(), path+"<-", depth)
+	}
+
+	return true
+}
+
+func visitOf(val reflect.Value) (visit, bool) {
+	if !val.IsValid() {
+		return visit{}, false
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if val.IsNil() {
+			return visit{}, false
+		}
+	case reflect.Slice:
+		if val.IsNil() || val.Len() == 0 {
+			return visit{}, false
+		}
+	default:
+		return visit{}, false
+	}
+
+	return visit{val.Pointer(), val.Type()}, true
+}
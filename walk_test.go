@@ -0,0 +1,152 @@
+package reflector
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestWalkNode struct {
+	Name     string
+	Next     *TestWalkNode
+	Children []any
+	Labels   map[string]int
+	Events   chan int
+	secret   int
+}
+
+type testWalkRecorder struct {
+	events []string
+	skip   string
+	stop   string
+}
+
+func (r *testWalkRecorder) Enter(node Node) WalkAction {
+	r.events = append(r.events, fmt.Sprintf("enter %s %s %d %t", node.Path(), node.ReflectType().String(), node.Depth(), node.IsCycle()))
+
+	if r.stop != "" && node.Path() == r.stop {
+		return WalkStop
+	}
+
+	if r.skip != "" && node.Path() == r.skip {
+		return WalkSkip
+	}
+
+	return WalkContinue
+}
+
+func (r *testWalkRecorder) Leave(node Node) WalkAction {
+	r.events = append(r.events, fmt.Sprintf("leave %s", node.Path()))
+	return WalkContinue
+}
+
+func TestWalk(t *testing.T) {
+	root := &TestWalkNode{
+		Name:     "root",
+		Children: []any{1, "two"},
+		Labels:   map[string]int{"b": 2, "a": 1},
+		secret:   5,
+	}
+	root.Next = root
+
+	recorder := &testWalkRecorder{}
+	err := Walk(root, recorder)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{
+		"enter  *reflector.TestWalkNode 0 false",
+		"enter  reflector.TestWalkNode 1 false",
+		"enter Name string 2 false",
+		"leave Name",
+		"enter Next *reflector.TestWalkNode 2 true",
+		"leave Next",
+		"enter Children []interface {} 2 false",
+		"enter Children[0] interface {} 3 false",
+		"enter Children[0] int 4 false",
+		"leave Children[0]",
+		"leave Children[0]",
+		"enter Children[1] interface {} 3 false",
+		"enter Children[1] string 4 false",
+		"leave Children[1]",
+		"leave Children[1]",
+		"leave Children",
+		"enter Labels map[string]int 2 false",
+		"enter Labels[\"a\"] int 3 false",
+		"leave Labels[\"a\"]",
+		"enter Labels[\"b\"] int 3 false",
+		"leave Labels[\"b\"]",
+		"leave Labels",
+		"enter Events chan int 2 false",
+		"enter Events<- int 3 false",
+		"leave Events<-",
+		"leave Events",
+		"enter secret int 2 false",
+		"leave secret",
+		"leave ",
+		"leave ",
+	}, recorder.events)
+}
+
+func TestWalkSkipAndStop(t *testing.T) {
+	root := &TestWalkNode{
+		Name:     "root",
+		Children: []any{1},
+		Labels:   map[string]int{"a": 1},
+	}
+
+	recorder := &testWalkRecorder{skip: "Children", stop: "Labels[\"a\"]"}
+	err := Walk(root, recorder)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{
+		"enter  *reflector.TestWalkNode 0 false",
+		"enter  reflector.TestWalkNode 1 false",
+		"enter Name string 2 false",
+		"leave Name",
+		"enter Next *reflector.TestWalkNode 2 false",
+		"leave Next",
+		"enter Children []interface {} 2 false",
+		"leave Children",
+		"enter Labels map[string]int 2 false",
+		"enter Labels[\"a\"] int 3 false",
+	}, recorder.events)
+}
+
+func TestWalkFunc(t *testing.T) {
+	first := &TestWalkNode{Name: "first"}
+	second := &TestWalkNode{Name: "second", Next: first}
+	first.Next = second
+
+	names := make([]string, 0)
+	err := Walk([]*TestWalkNode{first, second}, WalkFunc(func(node Node) WalkAction {
+		if IsString(node.Type()) && node.HasValue() {
+			val, err := node.Value()
+			assert.Nil(t, err)
+			names = append(names, node.Path()+"="+val.(string))
+		}
+
+		if node.Path() == "[0].secret" {
+			val, err := node.Value()
+			assert.Nil(t, val)
+			assert.NotNil(t, err)
+		}
+
+		return WalkContinue
+	}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"[0].Name=first",
+		"[0].Next.Name=second",
+		"[1].Name=second",
+		"[1].Next.Name=first",
+	}, names)
+
+	err = Walk(nil, WalkFunc(func(node Node) WalkAction {
+		return WalkContinue
+	}))
+	assert.NotNil(t, err)
+
+	err = Walk(first, nil)
+	assert.NotNil(t, err)
+}